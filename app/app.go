@@ -2,18 +2,33 @@ package app
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/audit"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/events"
 	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/exporter"
 	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/handler"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/health"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/influx"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/live"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/middleware"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/mqtt"
 	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/repository"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/rpc"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/tracing"
 	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/usecase"
 	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/utils"
 	"github.com/megadata-dev/go-snmp-olt-zte-c320/pkg/graceful"
 	"github.com/megadata-dev/go-snmp-olt-zte-c320/pkg/redis"
 	"github.com/megadata-dev/go-snmp-olt-zte-c320/pkg/snmp"
+	"github.com/prometheus/client_golang/prometheus"
 	rds "github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog/log"
 )
@@ -32,7 +47,7 @@ func New() *App {
 }
 
 // Start initializes the application components, sets up connections to external services
-// (Redis and SNMP), and starts the HTTP server. It handles graceful shutdown on context
+// (Redis and SNMP), and starts the HTTP and gRPC servers. It handles graceful shutdown on context
 // cancellation and ensures proper cleanup of resources.
 //
 // Parameters:
@@ -49,18 +64,43 @@ func (a *App) Start(ctx context.Context) error {
 	cfg, err := config.LoadConfig(configPath)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to load config")
+		return err
 	}
 
-	// Initialize Redis client
-	redisClient := redis.NewRedisClient(cfg)
+	// Add the trace ID of the request or collection to the log lines that carry its context
+	log.Logger = log.Logger.Hook(tracing.LogHook{})
 
-	// Check Redis connection
-	err = redisClient.Ping(ctx).Err()
-	if err != nil {
-		log.Error().Err(err).Msg("Failed to ping Redis server")
-	} else {
-		log.Info().Msg("Redis server successfully connected")
+	// Export the spans of requests and collections over OTLP, the environment overrides the config file
+	// in development and production
+	tracingCfg := cfg.TracingCfg
+	if os.Getenv("APP_ENV") == "development" || os.Getenv("APP_ENV") == "production" {
+		if enabled, err := strconv.ParseBool(os.Getenv("TRACING_ENABLED")); err == nil {
+			tracingCfg.Enabled = enabled
+		}
+		if endpoint := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"); endpoint != "" {
+			tracingCfg.Endpoint = endpoint
+		}
 	}
+	if tracingCfg.Enabled {
+		shutdownTracing, err := tracing.Setup(ctx, tracingCfg)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to setup tracing")
+		} else {
+			log.Info().Msgf("Traces are exported over OTLP with service name %s", tracingCfg.ServiceName)
+
+			// Flush the buffered spans after application shutdown
+			defer func() {
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				if err := shutdownTracing(shutdownCtx); err != nil {
+					log.Error().Err(err).Msg("Failed to shutdown tracing")
+				}
+			}()
+		}
+	}
+
+	// Initialize Redis client, it is checked by the readiness probe
+	redisClient := redis.NewRedisClient(cfg)
 
 	// Close Redis client
 	defer func(redisClient *rds.Client) {
@@ -70,52 +110,252 @@ func (a *App) Start(ctx context.Context) error {
 		}
 	}(redisClient)
 
-	// Initialize SNMP connection
+	// Initialize SNMP connection, without a valid SNMP target the service can not serve anything
 	snmpConn, err := snmp.SetupSnmpConnection(cfg)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to setup SNMP connection")
+		return err
 	}
 
-	// Check SNMP connection
-	/*
-		if SNMP Connection with wrong credentials in SNMP v3, return error is nil
-		if SNMP Connection with wrong Port in SNMP v2 v2c, return error is nil
-		if SNMP Connection with wrong community v2 v2c, return error is nil
+	// Close SNMP connection after application shutdown
+	defer func() {
+		if err := snmpConn.Conn.Close(); err != nil {
+			log.Error().Err(err).Msg("Failed to close SNMP connection")
+		}
+	}()
+
+	// Initialize repository, wrapped with the SNMP and cache metrics
+	snmpRepo := repository.NewInstrumentedSnmpRepository(
+		repository.NewPonRepository(snmpConn.Target, snmpConn.Community, snmpConn.Port),
+		net.JoinHostPort(snmpConn.Target, strconv.Itoa(int(snmpConn.Port))),
+	)
+	redisRepo := repository.NewInstrumentedOnuRedisRepo(repository.NewOnuRedisRepo(redisClient))
 
+	// Initialize the readiness checks
+	/*
 		Connect creates and opens a socket. Because UDP is a connectionless protocol,
 		you won't know if the remote host is responding until you send packets.
 		Neither will you know if the host is regularly disappearing and reappearing.
+
+		So the readiness probe reads the sysUpTime of the OLT, which also fails with a wrong community.
 	*/
+	healthChecker := health.NewChecker(5*time.Second, 5*time.Second,
+		health.Check{Name: "olt", Check: health.SnmpUptimeCheck(snmpRepo)},
+		health.Check{Name: "redis", Check: func(ctx context.Context) (string, error) {
+			return "", redisClient.Ping(ctx).Err()
+		}},
+	)
 
-	if snmpConn.Connect() != nil {
-		log.Error().Err(err).Msg("Failed to connect to SNMP server")
-	} else {
-		log.Info().Msg("SNMP server successfully connected")
+	// Check the dependencies once at startup, the service starts anyway and reports them on /readyz
+	for _, dependency := range healthChecker.Readiness(ctx).Dependencies {
+		if dependency.Status != model.HealthStatusUp {
+			log.Error().Str("dependency", dependency.Name).Str("error", dependency.LastError).
+				Msg("Dependency is down")
+		} else {
+			log.Info().Str("dependency", dependency.Name).Str("detail", dependency.Detail).Msg("Dependency is up")
+		}
 	}
 
-	// Close SNMP connection after application shutdown
-	defer func() {
-		if err := snmpConn.Conn.Close(); err != nil {
-			log.Error().Err(err).Msg("Failed to close SNMP connection")
+	// The OLT clock timezone, the environment overrides the config file in development and production
+	if os.Getenv("APP_ENV") == "development" || os.Getenv("APP_ENV") == "production" {
+		if timezone := os.Getenv("OLT_TIMEZONE"); timezone != "" {
+			cfg.OltCfg.Timezone = timezone
 		}
-	}()
+	}
+
+	// Initialize usecase, wrapped with a span for every call
+	onuUsecase := usecase.NewTracedOnuUsecase(usecase.NewOnuUsecase(snmpRepo, redisRepo, cfg))
+
+	// Resolve the OLT clock timezone, so every timestamp gets the right UTC offset
+	log.Info().Msgf("OLT timezone is %s", onuUsecase.GetOltLocation())
+
+	// Initialize and start the ONU event watcher, its broker keeps the last events for clients that reconnect.
+	// The environment overrides the config file in development and production
+	eventsCfg := cfg.EventsCfg
+	if os.Getenv("APP_ENV") == "development" || os.Getenv("APP_ENV") == "production" {
+		if seconds, err := strconv.Atoi(os.Getenv("EVENTS_POLL_INTERVAL")); err == nil {
+			eventsCfg.PollIntervalSeconds = seconds
+		}
+		if threshold, err := strconv.ParseFloat(os.Getenv("EVENTS_RX_POWER_THRESHOLD"), 64); err == nil {
+			eventsCfg.RxPowerThreshold = threshold
+		}
+		if size, err := strconv.Atoi(os.Getenv("EVENTS_BUFFER_SIZE")); err == nil {
+			eventsCfg.BufferSize = size
+		}
+	}
+	eventBroker := events.NewBroker(eventsCfg.BufferSize)
+	eventWatcher := events.NewWatcher(onuUsecase, eventBroker, eventsCfg)
+
+	// Publish the state and events of the ONUs to MQTT as well, the environment overrides the config file
+	// in development and production
+	mqttCfg := cfg.MqttCfg
+	if os.Getenv("APP_ENV") == "development" || os.Getenv("APP_ENV") == "production" {
+		if enabled, err := strconv.ParseBool(os.Getenv("MQTT_ENABLED")); err == nil {
+			mqttCfg.Enabled = enabled
+		}
+		if brokerURL := os.Getenv("MQTT_BROKER_URL"); brokerURL != "" {
+			mqttCfg.BrokerURL = brokerURL
+		}
+		if username := os.Getenv("MQTT_USERNAME"); username != "" {
+			mqttCfg.Username = username
+		}
+		if password := os.Getenv("MQTT_PASSWORD"); password != "" {
+			mqttCfg.Password = password
+		}
+	}
+	if mqttCfg.Enabled {
+		mqttPublisher, err := mqtt.NewPublisher(mqttCfg, snmpConn.Target)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to setup MQTT publisher")
+		} else {
+			eventWatcher.AddStateSink(mqttPublisher)
+			mqttPublisher.Start(ctx, eventBroker)
+			log.Info().Msgf("ONU states and events are published to MQTT broker %s", mqttCfg.BrokerURL)
+		}
+	}
+	eventWatcher.Start(ctx)
 
-	// Initialize repository
-	snmpRepo := repository.NewPonRepository(snmpConn.Target, snmpConn.Community, snmpConn.Port)
-	redisRepo := repository.NewOnuRedisRepo(redisClient)
+	// Initialize the live optical meter hub, it polls an ONU every 1 or 2 seconds while a session watches it.
+	// The environment overrides the config file in development and production
+	liveCfg := cfg.LiveCfg
+	if os.Getenv("APP_ENV") == "development" || os.Getenv("APP_ENV") == "production" {
+		if seconds, err := strconv.Atoi(os.Getenv("LIVE_POLL_INTERVAL")); err == nil {
+			liveCfg.PollIntervalSeconds = seconds
+		}
+		if sessions, err := strconv.Atoi(os.Getenv("LIVE_MAX_SESSIONS")); err == nil {
+			liveCfg.MaxSessions = sessions
+		}
+	}
+	liveHub := live.NewHub(onuUsecase, liveCfg)
 
-	// Initialize usecase
-	onuUsecase := usecase.NewOnuUsecase(snmpRepo, redisRepo, cfg)
+	// Get the auth, CORS and rate limit config, the environment overrides the config file in development and production
+	authCfg, corsCfg, rateLimitCfg := cfg.AuthCfg, cfg.CorsCfg, cfg.RateLimitCfg
+	if os.Getenv("APP_ENV") == "development" || os.Getenv("APP_ENV") == "production" {
+		if enabled, err := strconv.ParseBool(os.Getenv("AUTH_ENABLED")); err == nil {
+			authCfg.Enabled = enabled
+		}
+		if username := os.Getenv("METRICS_USERNAME"); username != "" {
+			authCfg.MetricsUsername = username
+		}
+		if passwordHash := os.Getenv("METRICS_PASSWORD_HASH"); passwordHash != "" {
+			authCfg.MetricsPasswordHash = passwordHash
+		}
+		if origins := os.Getenv("CORS_ALLOWED_ORIGINS"); origins != "" {
+			corsCfg.AllowedOrigins = strings.Split(origins, ",")
+		}
+		if enabled, err := strconv.ParseBool(os.Getenv("RATE_LIMIT_ENABLED")); err == nil {
+			rateLimitCfg.Enabled = enabled
+		}
+		if useRedis, err := strconv.ParseBool(os.Getenv("RATE_LIMIT_REDIS")); err == nil {
+			rateLimitCfg.Redis = useRedis
+		}
+		if proxies := os.Getenv("RATE_LIMIT_TRUSTED_PROXIES"); proxies != "" {
+			rateLimitCfg.TrustedProxies = strings.Split(proxies, ",")
+		}
+	}
+
+	// Initialize the API key authenticator, keys not found in the config are looked up in Redis
+	authenticator := middleware.NewAuthenticator(authCfg, repository.NewAPIKeyRedisRepo(redisClient))
+	if !authCfg.Enabled {
+		log.Warn().Msg("API key authentication is disabled")
+	}
+
+	// Initialize the per-client rate limiter, the buckets are kept in Redis when several replicas share the OLT
+	var rateLimitRepo repository.RateLimitRepositoryInterface
+	if rateLimitCfg.Redis {
+		rateLimitRepo = repository.NewRateLimitRedisRepo(redisClient)
+	}
+	rateLimiter := middleware.NewRateLimiter(rateLimitCfg, rateLimitRepo)
+
+	// Open the append-only audit log of the write and administrative operations
+	auditPath := cfg.AuditCfg.Path
+	if os.Getenv("APP_ENV") == "development" || os.Getenv("APP_ENV") == "production" {
+		if path := os.Getenv("AUDIT_LOG_PATH"); path != "" {
+			auditPath = path
+		}
+	}
+	if auditPath == "" {
+		auditPath = "audit.jsonl"
+	}
+	auditRepo, err := repository.NewAuditFileRepo(auditPath)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to open audit log")
+		return err
+	}
+	auditRecorder := audit.NewRecorder(auditRepo)
+	log.Info().Msgf("Audit log is written to %s", auditPath)
 
 	// Initialize handler
 	onuHandler := handler.NewOnuHandler(onuUsecase)
+	onuHandlerV2 := handler.NewOnuHandlerV2(onuUsecase)
+	eventHandler := handler.NewEventHandler(eventBroker)
+	liveHandler := handler.NewLiveHandler(liveHub, corsCfg.AllowedOrigins)
+	auditHandler := handler.NewAuditHandler(auditRecorder)
+	healthHandler := handler.NewHealthHandler(healthChecker)
+
+	// Initialize and start the Prometheus collector, /metrics serves its latest complete snapshot
+	onuCollector := exporter.NewOnuCollector(onuUsecase, exporter.ApplyEnv(cfg.ExporterCfg))
+	prometheus.MustRegister(onuCollector)
 
-	// Initialize and start the Prometheus collector
-	onuCollector := exporter.NewOnuCollector(onuUsecase)
+	// Write the measurements of every snapshot to InfluxDB as well, the environment overrides the config file
+	// in development and production
+	influxCfg := cfg.InfluxCfg
+	if os.Getenv("APP_ENV") == "development" || os.Getenv("APP_ENV") == "production" {
+		if enabled, err := strconv.ParseBool(os.Getenv("INFLUX_ENABLED")); err == nil {
+			influxCfg.Enabled = enabled
+		}
+		if influxURL := os.Getenv("INFLUX_URL"); influxURL != "" {
+			influxCfg.URL = influxURL
+		}
+		if org := os.Getenv("INFLUX_ORG"); org != "" {
+			influxCfg.Org = org
+		}
+		if bucket := os.Getenv("INFLUX_BUCKET"); bucket != "" {
+			influxCfg.Bucket = bucket
+		}
+		if token := os.Getenv("INFLUX_TOKEN"); token != "" {
+			influxCfg.Token = token
+		}
+	}
+	if influxCfg.Enabled {
+		influxWriter, err := influx.NewWriter(influxCfg, snmpConn.Target)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to setup InfluxDB output")
+		} else {
+			onuCollector.AddSink(influxWriter)
+			log.Info().Msgf("Exporter measurements are written to InfluxDB bucket %s", influxCfg.Bucket)
+		}
+	}
 	onuCollector.Start(ctx)
 
+	// Start the gRPC server on its own port, it shares the usecase with the HTTP handlers
+	grpcPort := cfg.GrpcCfg.Port
+	if os.Getenv("APP_ENV") == "development" || os.Getenv("APP_ENV") == "production" {
+		if port := os.Getenv("GRPC_PORT"); port != "" {
+			grpcPort = port
+		}
+	}
+
+	if grpcPort != "" {
+		grpcListener, err := net.Listen("tcp", ":"+grpcPort)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to listen on gRPC port")
+		} else {
+			grpcServer := rpc.NewServer(onuUsecase, eventBroker, authenticator)
+			go func() {
+				if err := grpcServer.Serve(grpcListener); err != nil {
+					log.Error().Err(err).Msg("Failed to serve gRPC")
+				}
+			}()
+			defer rpc.Stop(grpcServer, 10*time.Second)
+
+			log.Info().Msgf("gRPC server started at %s", grpcPort)
+		}
+	}
+
 	// Initialize router
-	a.router = loadRoutes(onuHandler)
+	a.router = loadRoutes(onuHandler, onuHandlerV2, eventHandler, liveHandler, auditHandler, healthHandler,
+		authenticator, rateLimiter, auditRecorder, corsCfg.AllowedOrigins)
 
 	// Start server
 	addr := "8081"
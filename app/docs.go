@@ -0,0 +1,71 @@
+package app
+
+import (
+	"embed"
+	"io/fs"
+	"mime"
+	"net/http"
+	"path"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// openAPISpec is the OpenAPI 3 document of every route in loadRoutes, routes_test.go keeps it in sync
+//
+//go:embed openapi.json
+var openAPISpec []byte
+
+// swaggerUIAssets are the Swagger UI assets of swagger-ui-dist 5.18.2, embedded so /docs works without internet
+// access
+//
+//go:embed swagger-ui/swagger-ui.css swagger-ui/swagger-ui-bundle.js
+var swaggerUIAssets embed.FS
+
+// swaggerUIPage renders openAPISpec with Swagger UI, the Swagger UI assets are served from swaggerUIAssets
+const swaggerUIPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>Monitoring OLT ZTE C320 with SNMP - API docs</title>
+  <link rel="stylesheet" href="/docs/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="/docs/swagger-ui-bundle.js"></script>
+  <script>
+    window.onload = function () {
+      SwaggerUIBundle({ url: "/openapi.json", dom_id: "#swagger-ui" });
+    };
+  </script>
+</body>
+</html>
+`
+
+// openAPIHandler serves the OpenAPI document
+func openAPIHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json") // Set content type to JSON
+	w.WriteHeader(http.StatusOK)                       // Set HTTP status code to 200
+	_, _ = w.Write(openAPISpec)                        // Write OpenAPI document
+}
+
+// swaggerUIHandler serves the Swagger UI page
+func swaggerUIHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8") // Set content type to HTML
+	w.WriteHeader(http.StatusOK)                               // Set HTTP status code to 200
+	_, _ = w.Write([]byte(swaggerUIPage))                      // Write Swagger UI page
+}
+
+// swaggerUIAssetHandler serves an embedded Swagger UI asset, unknown assets return 404
+func swaggerUIAssetHandler(w http.ResponseWriter, r *http.Request) {
+	asset := chi.URLParam(r, "asset")
+	content, err := fs.ReadFile(swaggerUIAssets, path.Join("swagger-ui", path.Base(asset)))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", mime.TypeByExtension(path.Ext(asset))) // Set content type of the asset
+	w.Header().Set("Cache-Control", "public, max-age=86400")              // The assets only change with the binary
+	w.WriteHeader(http.StatusOK)                                          // Set HTTP status code to 200
+	_, _ = w.Write(content)                                               // Write asset
+}
@@ -5,14 +5,22 @@ import (
 	"os"
 
 	"github.com/go-chi/chi/v5"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/audit"
 	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/handler"
 	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/middleware"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 )
 
-func loadRoutes(onuHandler *handler.OnuHandler) http.Handler {
+func loadRoutes(
+	onuHandler *handler.OnuHandler, onuHandlerV2 *handler.OnuHandlerV2, eventHandler *handler.EventHandler,
+	liveHandler *handler.LiveHandler, auditHandler *handler.AuditHandler, healthHandler *handler.HealthHandler,
+	authenticator *middleware.Authenticator, rateLimiter *middleware.RateLimiter, auditRecorder *audit.Recorder,
+	allowedOrigins []string,
+) http.Handler {
 
 	// Initialize logger
 	l := log.Output(zerolog.ConsoleWriter{
@@ -22,37 +30,126 @@ func loadRoutes(onuHandler *handler.OnuHandler) http.Handler {
 	// Initialize router using chi
 	router := chi.NewRouter()
 
+	// Middleware for the request ID, logged and recorded in the audit log
+	router.Use(chimiddleware.RequestID)
+
+	// Middleware for the request span, before the logger so its lines carry the trace ID
+	router.Use(middleware.Tracing)
+
 	// Middleware for logging requests
 	router.Use(middleware.Logger(l))
 
+	// Middleware for the request duration metrics by route pattern
+	router.Use(middleware.Metrics)
+
 	// Middleware for CORS
-	router.Use(middleware.CorsMiddleware())
+	router.Use(middleware.CorsMiddleware(allowedOrigins))
 
 	// Define a simple root endpoint
 	router.Get("/", rootHandler)
 
-	// Create a group for /api/v1/
+	// Define the liveness and readiness probe endpoints, they are public for Kubernetes and the load balancer
+	router.Get("/healthz", healthHandler.Liveness)
+	router.Get("/readyz", healthHandler.Readiness)
+
+	// Define the OpenAPI document and Swagger UI endpoints
+	router.Get("/openapi.json", openAPIHandler)
+	router.Get("/docs", swaggerUIHandler)
+	router.Get("/docs/{asset}", swaggerUIAssetHandler)
+
+	// Rate limits of the route classes, the routes that read the OLT on every request cost more than cached reads
+	cached := rateLimiter.Limit(middleware.RouteClassCached)
+	snmp := rateLimiter.Limit(middleware.RouteClassSNMP)
+	export := rateLimiter.Limit(middleware.RouteClassExport)
+	update := rateLimiter.Limit(middleware.RouteClassUpdate)
+
+	// Create a group for /api/v1/, reading the ONU data requires the viewer role
 	apiV1Group := chi.NewRouter()
+	apiV1Group.Use(authenticator.RequireRole(model.RoleViewer))
 
 	// Define routes for /api/v1/
 	apiV1Group.Route("/board", func(r chi.Router) {
-		r.Get("/{board_id}/pon/{pon_id}", onuHandler.GetByBoardIDAndPonID)
-		r.Get("/{board_id}/pon/{pon_id}/onu/{onu_id}", onuHandler.GetByBoardIDPonIDAndOnuID)
-		r.Get("/{board_id}/pon/{pon_id}/onu_id/empty", onuHandler.GetEmptyOnuID)
-		r.Get("/{board_id}/pon/{pon_id}/onu_id_sn", onuHandler.GetOnuIDAndSerialNumber)
-		r.Get("/{board_id}/pon/{pon_id}/onu_id/update", onuHandler.UpdateEmptyOnuID)
+		r.With(cached).Get("/{board_id}/pon/{pon_id}", onuHandler.GetByBoardIDAndPonID)
+		r.With(snmp).Get("/{board_id}/pon/{pon_id}/onu/{onu_id}", onuHandler.GetByBoardIDPonIDAndOnuID)
+		r.With(snmp).Get("/{board_id}/pon/{pon_id}/onu/{onu_id}/uni", onuHandler.GetOnuUniPorts)
+		r.With(snmp).Get("/{board_id}/pon/{pon_id}/onu/{onu_id}/live", liveHandler.OnuLive)
+		r.With(snmp).Get("/{board_id}/pon/{pon_id}/top_talkers", onuHandler.GetTopTalkers)
+		r.With(cached).Get("/{board_id}/pon/{pon_id}/onu_id/empty", onuHandler.GetEmptyOnuID)
+		r.With(snmp).Get("/{board_id}/pon/{pon_id}/onu_id_sn", onuHandler.GetOnuIDAndSerialNumber)
+
+		// Refreshing the cached empty ONU IDs reads the OLT again, it requires the operator role and is audited
+		r.With(auditRecorder.Middleware(audit.ActionUpdateEmptyOnuID), authenticator.RequireRole(model.RoleOperator),
+			update).Get("/{board_id}/pon/{pon_id}/onu_id/update", onuHandler.UpdateEmptyOnuID)
+	})
+
+	// Define route for the ONU list of every board and PON
+	apiV1Group.With(cached).Get("/onu", onuHandler.GetAll)
+
+	// Define route for the ONU inventory export
+	apiV1Group.With(export).Get("/export", onuHandler.ExportOnuInventory)
+
+	// Define route for the server-sent events stream of ONU changes
+	apiV1Group.Route("/events", func(r chi.Router) {
+		r.With(cached).Get("/stream", eventHandler.Stream)
 	})
 
 	// Define routes for /api/v1/paginate
 	apiV1Group.Route("/paginate", func(r chi.Router) {
-		r.Get("/board/{board_id}/pon/{pon_id}", onuHandler.GetByBoardIDAndPonIDWithPaginate)
+		r.With(cached).Get("/board/{board_id}/pon/{pon_id}", onuHandler.GetByBoardIDAndPonIDWithPaginate)
+	})
+
+	// Define routes for /api/v1/inventory
+	apiV1Group.Route("/inventory", func(r chi.Router) {
+		r.With(cached).Get("/firmware", onuHandler.GetOnuFirmwareInventory)
+	})
+
+	// Define routes for the audit log, it requires the operator role
+	apiV1Group.Group(func(r chi.Router) {
+		r.Use(authenticator.RequireRole(model.RoleOperator))
+		r.With(cached).Get("/audit", auditHandler.Query)
+		r.With(export).Get("/audit/export", auditHandler.Export)
 	})
 
 	// Mount /api/v1/ to root router
 	router.Mount("/api/v1", apiV1Group)
 
-	// Add Prometheus /metrics endpoint
-	router.Handle("/metrics", promhttp.Handler())
+	// Create a group for /api/v2/ with typed models, routes whose v1 model is already typed are shared, reading the
+	// ONU data requires the viewer role
+	apiV2Group := chi.NewRouter()
+	apiV2Group.Use(authenticator.RequireRole(model.RoleViewer))
+
+	// Define routes for /api/v2/
+	apiV2Group.Route("/board", func(r chi.Router) {
+		r.With(cached).Get("/{board_id}/pon/{pon_id}", onuHandlerV2.GetByBoardIDAndPonID)
+		r.With(snmp).Get("/{board_id}/pon/{pon_id}/onu/{onu_id}", onuHandlerV2.GetByBoardIDPonIDAndOnuID)
+		r.With(snmp).Get("/{board_id}/pon/{pon_id}/onu/{onu_id}/uni", onuHandler.GetOnuUniPorts)
+		r.With(snmp).Get("/{board_id}/pon/{pon_id}/top_talkers", onuHandler.GetTopTalkers)
+		r.With(cached).Get("/{board_id}/pon/{pon_id}/onu_id/empty", onuHandler.GetEmptyOnuID)
+		r.With(snmp).Get("/{board_id}/pon/{pon_id}/onu_id_sn", onuHandler.GetOnuIDAndSerialNumber)
+
+		// Refreshing the cached empty ONU IDs reads the OLT again, it requires the operator role and is audited
+		r.With(auditRecorder.Middleware(audit.ActionUpdateEmptyOnuID), authenticator.RequireRole(model.RoleOperator),
+			update).Get("/{board_id}/pon/{pon_id}/onu_id/update", onuHandler.UpdateEmptyOnuID)
+	})
+
+	// Define route for the ONU list of every board and PON
+	apiV2Group.With(cached).Get("/onu", onuHandlerV2.GetAll)
+
+	// Define routes for /api/v2/paginate
+	apiV2Group.Route("/paginate", func(r chi.Router) {
+		r.With(cached).Get("/board/{board_id}/pon/{pon_id}", onuHandlerV2.GetByBoardIDAndPonIDWithPaginate)
+	})
+
+	// Define routes for /api/v2/inventory
+	apiV2Group.Route("/inventory", func(r chi.Router) {
+		r.With(cached).Get("/firmware", onuHandler.GetOnuFirmwareInventory)
+	})
+
+	// Mount /api/v2/ to root router
+	router.Mount("/api/v2", apiV2Group)
+
+	// Add Prometheus /metrics endpoint, with its own optional credentials for the Prometheus server
+	router.With(authenticator.MetricsAuth()).Handle("/metrics", promhttp.Handler())
 
 	return router
 }
@@ -0,0 +1,137 @@
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/audit"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/events"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/handler"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/health"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/live"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+// openAPIDocument is the part of the OpenAPI document checked against the router
+type openAPIDocument struct {
+	OpenAPI    string                                `json:"openapi"`
+	Paths      map[string]map[string]json.RawMessage `json:"paths"`
+	Components map[string]map[string]json.RawMessage `json:"components"`
+}
+
+// newTestRouter loads the routes with handlers that have no dependencies, enough to walk and document the routes
+func newTestRouter() http.Handler {
+	return loadRoutes(handler.NewOnuHandler(nil), handler.NewOnuHandlerV2(nil),
+		handler.NewEventHandler(events.NewBroker(0)), handler.NewLiveHandler(live.NewHub(nil, config.LiveConfig{}), nil),
+		handler.NewAuditHandler(audit.NewRecorder(nil)), handler.NewHealthHandler(health.NewChecker(time.Second, 0)),
+		middleware.NewAuthenticator(config.AuthConfig{}, nil),
+		middleware.NewRateLimiter(config.RateLimitConfig{}, nil), audit.NewRecorder(nil), nil)
+}
+
+func loadOpenAPIDocument(t *testing.T) openAPIDocument {
+	var document openAPIDocument
+	if err := json.Unmarshal(openAPISpec, &document); err != nil {
+		t.Fatalf("openapi.json is not valid JSON: %v", err)
+	}
+	return document
+}
+
+// TestOpenAPISpecMatchesRoutes fails when a route is added to or removed from loadRoutes without updating openapi.json
+func TestOpenAPISpecMatchesRoutes(t *testing.T) {
+	router := newTestRouter()
+
+	var routes []string
+	err := chi.Walk(router.(chi.Routes), func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		if method == http.MethodGet {
+			routes = append(routes, method+" "+strings.TrimSuffix(route, "/*"))
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+
+	var documented []string
+	for path, operations := range loadOpenAPIDocument(t).Paths {
+		for method := range operations {
+			documented = append(documented, strings.ToUpper(method)+" "+path)
+		}
+	}
+
+	sort.Strings(routes)
+	sort.Strings(documented)
+	assert.Equal(t, routes, documented, "openapi.json paths must match the routes in loadRoutes")
+}
+
+// TestOpenAPISpecReferences fails when a $ref points to a component that does not exist
+func TestOpenAPISpecReferences(t *testing.T) {
+	document := loadOpenAPIDocument(t)
+	assert.True(t, strings.HasPrefix(document.OpenAPI, "3."), "openapi version must be 3.x")
+
+	var walk func(value interface{})
+	walk = func(value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if ref, ok := v["$ref"].(string); ok {
+				parts := strings.Split(strings.TrimPrefix(ref, "#/components/"), "/")
+				if assert.Len(t, parts, 2, ref) {
+					_, exists := document.Components[parts[0]][parts[1]]
+					assert.True(t, exists, "unresolved reference %s", ref)
+				}
+			}
+			for _, item := range v {
+				walk(item)
+			}
+		case []interface{}:
+			for _, item := range v {
+				walk(item)
+			}
+		}
+	}
+
+	var spec interface{}
+	assert.NoError(t, json.Unmarshal(openAPISpec, &spec))
+	walk(spec)
+}
+
+func TestDocsRoutes(t *testing.T) {
+	router := newTestRouter()
+
+	tests := []struct {
+		path        string
+		contentType string
+	}{
+		{"/openapi.json", "application/json"},
+		{"/docs", "text/html; charset=utf-8"},
+		{"/docs/swagger-ui.css", "text/css; charset=utf-8"},
+		{"/docs/swagger-ui-bundle.js", "text/javascript; charset=utf-8"},
+		{"/healthz", "application/json"},
+		{"/readyz", "application/json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			assert.Equal(t, tt.contentType, recorder.Header().Get("Content-Type"))
+		})
+	}
+}
+
+func TestDocsUnknownAsset(t *testing.T) {
+	router := newTestRouter()
+
+	for _, path := range []string{"/docs/index.html", "/docs/..%2Fopenapi.json"} {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		assert.Equal(t, http.StatusNotFound, recorder.Code, path)
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/middleware"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/repository"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// apikey generates an API key and prints its hash for the config file or Redis, or hashes the metrics password.
+//
+//	go run ./cmd/apikey -name noc-dashboard -role viewer
+//	go run ./cmd/apikey -metrics-password secret
+func main() {
+	name := flag.String("name", "", "name of the API key owner")
+	role := flag.String("role", model.RoleViewer, "role of the API key: viewer, operator or provisioner")
+	metricsPassword := flag.String("metrics-password", "", "hash this metrics password with bcrypt instead")
+	flag.Parse()
+
+	// Hash the metrics password
+	if *metricsPassword != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(*metricsPassword), bcrypt.DefaultCost)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Failed to hash metrics password:", err)
+			os.Exit(1)
+		}
+		fmt.Printf("metrics_password_hash : \"%s\"\n", hash)
+		return
+	}
+
+	if *name == "" {
+		fmt.Fprintln(os.Stderr, "-name is required")
+		os.Exit(2)
+	}
+	if *role != model.RoleViewer && *role != model.RoleOperator && *role != model.RoleProvisioner {
+		fmt.Fprintln(os.Stderr, "-role must be viewer, operator or provisioner")
+		os.Exit(2)
+	}
+
+	// Generate a random 256 bit key
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to generate API key:", err)
+		os.Exit(1)
+	}
+	key := base64.RawURLEncoding.EncodeToString(secret)
+	keyHash := middleware.HashAPIKey(key)
+
+	value, _ := json.Marshal(model.APIKey{Name: *name, Role: *role})
+
+	fmt.Printf("API key (shown only once): %s\n\n", key)
+	fmt.Printf("Config file, under AuthCfg.api_keys:\n")
+	fmt.Printf("    - name : \"%s\"\n      key_hash : \"%s\"\n      role : \"%s\"\n\n", *name, keyHash, *role)
+	fmt.Printf("Or Redis:\n")
+	fmt.Printf("redis-cli HSET %s %s '%s'\n", repository.APIKeysRedisKey, keyHash, value)
+}
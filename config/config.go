@@ -7,43 +7,56 @@ import (
 )
 
 // Config represents the main application configuration structure
-// that contains all sub-configurations for SNMP, Redis, OLT, and individual PON boards.
+// that contains all sub-configurations for SNMP, Redis, gRPC, authentication, CORS, rate limiting,
+// audit log, Prometheus exporter, InfluxDB output, tracing, ONU events, live optical meter, MQTT publishing, OLT,
+// and individual PON boards.
 type Config struct {
-	SnmpCfg     SnmpConfig
-	RedisCfg    RedisConfig
-	OltCfg      OltConfig
-	Board1Pon1  Board1Pon1
-	Board1Pon2  Board1Pon2
-	Board1Pon3  Board1Pon3
-	Board1Pon4  Board1Pon4
-	Board1Pon5  Board1Pon5
-	Board1Pon6  Board1Pon6
-	Board1Pon7  Board1Pon7
-	Board1Pon8  Board1Pon8
-	Board1Pon9  Board1Pon9
-	Board1Pon10 Board1Pon10
-	Board1Pon11 Board1Pon11
-	Board1Pon12 Board1Pon12
-	Board1Pon13 Board1Pon13
-	Board1Pon14 Board1Pon14
-	Board1Pon15 Board1Pon15
-	Board1Pon16 Board1Pon16
-	Board2Pon1  Board2Pon1
-	Board2Pon2  Board2Pon2
-	Board2Pon3  Board2Pon3
-	Board2Pon4  Board2Pon4
-	Board2Pon5  Board2Pon5
-	Board2Pon6  Board2Pon6
-	Board2Pon7  Board2Pon7
-	Board2Pon8  Board2Pon8
-	Board2Pon9  Board2Pon9
-	Board2Pon10 Board2Pon10
-	Board2Pon11 Board2Pon11
-	Board2Pon12 Board2Pon12
-	Board2Pon13 Board2Pon13
-	Board2Pon14 Board2Pon14
-	Board2Pon15 Board2Pon15
-	Board2Pon16 Board2Pon16
+	SnmpCfg      SnmpConfig
+	RedisCfg     RedisConfig
+	OltCfg       OltConfig
+	GrpcCfg      GrpcConfig
+	AuthCfg      AuthConfig
+	CorsCfg      CorsConfig
+	RateLimitCfg RateLimitConfig
+	AuditCfg     AuditConfig
+	ExporterCfg  ExporterConfig
+	InfluxCfg    InfluxConfig
+	TracingCfg   TracingConfig
+	EventsCfg    EventsConfig
+	LiveCfg      LiveConfig
+	MqttCfg      MqttConfig
+	Board1Pon1   Board1Pon1
+	Board1Pon2   Board1Pon2
+	Board1Pon3   Board1Pon3
+	Board1Pon4   Board1Pon4
+	Board1Pon5   Board1Pon5
+	Board1Pon6   Board1Pon6
+	Board1Pon7   Board1Pon7
+	Board1Pon8   Board1Pon8
+	Board1Pon9   Board1Pon9
+	Board1Pon10  Board1Pon10
+	Board1Pon11  Board1Pon11
+	Board1Pon12  Board1Pon12
+	Board1Pon13  Board1Pon13
+	Board1Pon14  Board1Pon14
+	Board1Pon15  Board1Pon15
+	Board1Pon16  Board1Pon16
+	Board2Pon1   Board2Pon1
+	Board2Pon2   Board2Pon2
+	Board2Pon3   Board2Pon3
+	Board2Pon4   Board2Pon4
+	Board2Pon5   Board2Pon5
+	Board2Pon6   Board2Pon6
+	Board2Pon7   Board2Pon7
+	Board2Pon8   Board2Pon8
+	Board2Pon9   Board2Pon9
+	Board2Pon10  Board2Pon10
+	Board2Pon11  Board2Pon11
+	Board2Pon12  Board2Pon12
+	Board2Pon13  Board2Pon13
+	Board2Pon14  Board2Pon14
+	Board2Pon15  Board2Pon15
+	Board2Pon16  Board2Pon16
 }
 
 // SnmpConfig contains configuration parameters for SNMP connection
@@ -67,526 +80,1158 @@ type RedisConfig struct {
 	PoolTimeout        int    `mapstructure:"pool_timeout"`
 }
 
+// GrpcConfig contains configuration parameters for the gRPC server
+type GrpcConfig struct {
+	Port string `mapstructure:"port"` // Listen port of the gRPC server, empty disables the server
+}
+
+// AuthConfig contains configuration parameters for the API key authentication
+// and the optional basic authentication of the Prometheus metrics endpoint.
+type AuthConfig struct {
+	Enabled             bool           `mapstructure:"enabled"`
+	APIKeys             []APIKeyConfig `mapstructure:"api_keys"`
+	MetricsUsername     string         `mapstructure:"metrics_username"`
+	MetricsPasswordHash string         `mapstructure:"metrics_password_hash"` // bcrypt hash, empty leaves /metrics open
+}
+
+// APIKeyConfig contains an API key of the configuration, the key itself is never stored.
+type APIKeyConfig struct {
+	Name    string `mapstructure:"name"`
+	KeyHash string `mapstructure:"key_hash"` // SHA-256 hex hash of the key
+	Role    string `mapstructure:"role"`     // viewer, operator or provisioner
+}
+
+// CorsConfig contains the origins allowed to call the API from a browser.
+type CorsConfig struct {
+	AllowedOrigins []string `mapstructure:"allowed_origins"` // e.g. https://noc.example.com, empty allows none
+}
+
+// RateLimitConfig contains configuration parameters for the per-client token bucket rate limiter
+// that protects the OLT SNMP agent. A request takes the cost of its route class from the bucket.
+type RateLimitConfig struct {
+	Enabled         bool               `mapstructure:"enabled"`
+	Capacity        float64            `mapstructure:"capacity"`          // Tokens a client can spend in a burst
+	RefillPerSecond float64            `mapstructure:"refill_per_second"` // Tokens given back to a client every second
+	Redis           bool               `mapstructure:"redis"`             // Keep the buckets in Redis, shared by replicas
+	Costs           map[string]float64 `mapstructure:"costs"`             // Tokens per request of each route class
+	TrustedProxies  []string           `mapstructure:"trusted_proxies"`   // IPs or CIDRs whose forwarded client IP is used
+}
+
+// AuditConfig contains configuration parameters for the append-only audit log
+type AuditConfig struct {
+	Path string `mapstructure:"path"` // JSON lines file of the audit log
+}
+
+// ExporterConfig contains the collection schedule of the Prometheus exporter. Zero values fall back to the
+// defaults of the exporter package.
+type ExporterConfig struct {
+	Ranges           []ExporterRangeConfig `mapstructure:"ranges"`             // Boards and PONs to collect, default board 1-2 PON 1-16
+	IntervalSeconds  int                   `mapstructure:"interval_seconds"`   // Time between the starts of two cycles
+	JitterSeconds    int                   `mapstructure:"jitter_seconds"`     // Random delay added to the start of a cycle
+	Workers          int                   `mapstructure:"workers"`            // PONs collected at the same time
+	OverrunPolicy    string                `mapstructure:"overrun_policy"`     // skip or queue a cycle that is due while one runs
+	RxPowerThreshold float64               `mapstructure:"rx_power_threshold"` // dBm, 0 uses the default of -27
+	InfoLabels       []string              `mapstructure:"info_labels"`        // Labels of zte_onu_info, empty uses the defaults
+}
+
+// ExporterRangeConfig contains a range of boards and PONs the exporter collects.
+type ExporterRangeConfig struct {
+	BoardMin int `mapstructure:"board_min"`
+	BoardMax int `mapstructure:"board_max"`
+	PonMin   int `mapstructure:"pon_min"`
+	PonMax   int `mapstructure:"pon_max"`
+}
+
+// InfluxConfig contains configuration parameters for the optional InfluxDB v2 output, it writes the ONU and PON
+// measurements of every exporter snapshot. Zero values fall back to the defaults of the influx package.
+type InfluxConfig struct {
+	Enabled        bool   `mapstructure:"enabled"`
+	URL            string `mapstructure:"url"` // e.g. http://influxdb:8086
+	Org            string `mapstructure:"org"`
+	Bucket         string `mapstructure:"bucket"`
+	Token          string `mapstructure:"token"`
+	BatchSize      int    `mapstructure:"batch_size"`      // Lines per write request, default 5000
+	MaxRetries     int    `mapstructure:"max_retries"`     // Retries of a batch that failed with a network error, 429 or 5xx
+	TimeoutSeconds int    `mapstructure:"timeout_seconds"` // Timeout of a single write request, default 10
+	Gzip           bool   `mapstructure:"gzip"`            // Compress the request body
+}
+
+// TracingConfig contains configuration parameters for the OpenTelemetry tracing of the HTTP routes, usecase,
+// SNMP and Redis calls. The spans are exported over OTLP gRPC.
+type TracingConfig struct {
+	Enabled     bool    `mapstructure:"enabled"`
+	Endpoint    string  `mapstructure:"endpoint"`     // host:port of the OTLP gRPC receiver, default localhost:4317
+	Insecure    bool    `mapstructure:"insecure"`     // Connect without TLS, e.g. to a collector sidecar
+	ServiceName string  `mapstructure:"service_name"` // default go-snmp-olt-zte-c320
+	SampleRatio float64 `mapstructure:"sample_ratio"` // Share of the traces recorded, 0 records all of them
+}
+
+// EventsConfig contains configuration parameters for the ONU event watcher behind the event stream, the gRPC status
+// watch and the MQTT publisher. Zero values fall back to the defaults of the events package.
+type EventsConfig struct {
+	PollIntervalSeconds int     `mapstructure:"poll_interval_seconds"` // Time between two polls of every PON
+	RxPowerThreshold    float64 `mapstructure:"rx_power_threshold"`    // dBm, 0 uses the default of -27
+	BufferSize          int     `mapstructure:"buffer_size"`           // Events kept for clients that reconnect
+}
+
+// LiveConfig contains configuration parameters for the live optical meter. Zero values fall back to the defaults
+// of the live package.
+type LiveConfig struct {
+	PollIntervalSeconds int `mapstructure:"poll_interval_seconds"` // Time between two polls of a watched ONU, 1 or 2
+	MaxSessions         int `mapstructure:"max_sessions"`          // Live sessions allowed at once, default 10
+}
+
+// MqttConfig contains configuration parameters for the optional MQTT publisher of the ONU state and events.
+// Zero values fall back to the defaults of the mqtt package.
+type MqttConfig struct {
+	Enabled               bool   `mapstructure:"enabled"`
+	BrokerURL             string `mapstructure:"broker_url"` // e.g. tcp://mosquitto:1883, ssl://broker:8883 for TLS
+	ClientID              string `mapstructure:"client_id"`  // default go-snmp-olt-zte-c320-<olt>
+	Username              string `mapstructure:"username"`
+	Password              string `mapstructure:"password"`
+	QoS                   int    `mapstructure:"qos"`          // 0, 1 or 2
+	TopicPrefix           string `mapstructure:"topic_prefix"` // First topic level, default olt
+	OltName               string `mapstructure:"olt_name"`     // Topic level of the OLT, default the SNMP target
+	TimeoutSeconds        int    `mapstructure:"timeout_seconds"`
+	TLSCAFile             string `mapstructure:"tls_ca_file"`   // CA of the broker certificate, default the system roots
+	TLSCertFile           string `mapstructure:"tls_cert_file"` // Client certificate for mutual TLS
+	TLSKeyFile            string `mapstructure:"tls_key_file"`
+	TLSInsecureSkipVerify bool   `mapstructure:"tls_insecure_skip_verify"`
+}
+
 // OltConfig contains base OID configurations for OLT device management
-// including common OIDs for ONU identification and type mapping.
+// including common OIDs for ONU identification and type mapping, and the OLT clock timezone.
 type OltConfig struct {
 	BaseOID1        string `mapstructure:"base_oid_1"`
 	BaseOID2        string `mapstructure:"base_oid_2"`
 	OnuIDNameAllPon string `mapstructure:"onu_id_name"`
 	OnuTypeAllPon   string `mapstructure:"onu_type"`
+	Timezone        string `mapstructure:"timezone"` // IANA name or UTC offset of the OLT clock, "auto" reads it from the OLT
 }
 
 // Board1Pon1 contains OID configurations for Board 1 Port 1 ONU management
 // including identifiers, status, power levels, and diagnostic information.
 type Board1Pon1 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board1Pon2 contains OID configurations for Board 1 Port 2 ONU management.
 type Board1Pon2 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board1Pon3 contains OID configurations for Board 1 Port 3 ONU management.
 type Board1Pon3 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board1Pon4 contains OID configurations for Board 1 Port 4 ONU management.
 type Board1Pon4 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board1Pon5 contains OID configurations for Board 1 Port 5 ONU management.
 type Board1Pon5 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board1Pon6 contains OID configurations for Board 1 Port 6 ONU management.
 type Board1Pon6 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board1Pon7 contains OID configurations for Board 1 Port 7 ONU management.
 type Board1Pon7 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board1Pon8 contains OID configurations for Board 1 Port 8 ONU management.
 type Board1Pon8 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board1Pon9 contains OID configurations for Board 1 Port 9 ONU management.
 type Board1Pon9 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board1Pon10 contains OID configurations for Board 1 Port 10 ONU management.
 type Board1Pon10 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board1Pon11 contains OID configurations for Board 1 Port 11 ONU management.
 type Board1Pon11 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board1Pon12 contains OID configurations for Board 1 Port 12 ONU management.
 type Board1Pon12 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board1Pon13 contains OID configurations for Board 1 Port 13 ONU management.
 type Board1Pon13 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board1Pon14 contains OID configurations for Board 1 Port 14 ONU management.
 type Board1Pon14 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board1Pon15 contains OID configurations for Board 1 Port 15 ONU management.
 type Board1Pon15 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board1Pon16 contains OID configurations for Board 1 Port 16 ONU management.
 type Board1Pon16 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board2Pon1 contains OID configurations for Board 2 Port 1 ONU management.
 type Board2Pon1 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board2Pon2 contains OID configurations for Board 2 Port 2 ONU management.
 type Board2Pon2 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board2Pon3 contains OID configurations for Board 2 Port 3 ONU management.
 type Board2Pon3 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board2Pon4 contains OID configurations for Board 2 Port 4 ONU management.
 type Board2Pon4 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board2Pon5 contains OID configurations for Board 2 Port 5 ONU management.
 type Board2Pon5 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board2Pon6 contains OID configurations for Board 2 Port 6 ONU management.
 type Board2Pon6 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board2Pon7 contains OID configurations for Board 2 Port 7 ONU management.
 type Board2Pon7 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board2Pon8 contains OID configurations for Board 2 Port 8 ONU management.
 type Board2Pon8 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board2Pon9 contains OID configurations for Board 2 Port 9 ONU management.
 type Board2Pon9 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board2Pon10 contains OID configurations for Board 2 Port 10 ONU management.
 type Board2Pon10 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board2Pon11 contains OID configurations for Board 2 Port 11 ONU management.
 type Board2Pon11 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board2Pon12 contains OID configurations for Board 2 Port 12 ONU management.
 type Board2Pon12 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board2Pon13 contains OID configurations for Board 2 Port 13 ONU management.
 type Board2Pon13 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board2Pon14 contains OID configurations for Board 2 Port 14 ONU management.
 type Board2Pon14 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board2Pon15 contains OID configurations for Board 2 Port 15 ONU management.
 type Board2Pon15 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // Board2Pon16 contains OID configurations for Board 2 Port 16 ONU management.
 type Board2Pon16 struct {
-	OnuIDNameOID              string `mapstructure:"onu_id_name"`
-	OnuTypeOID                string `mapstructure:"onu_type"`
-	OnuSerialNumberOID        string `mapstructure:"onu_serial_number"`
-	OnuRxPowerOID             string `mapstructure:"onu_rx_power"`
-	OnuTxPowerOID             string `mapstructure:"onu_tx_power"`
-	OnuStatusOID              string `mapstructure:"onu_status_id"`
-	OnuIPAddressOID           string `mapstructure:"onu_ip_address"`
-	OnuDescriptionOID         string `mapstructure:"onu_description"`
-	OnuLastOnlineOID          string `mapstructure:"onu_last_online_time"`
-	OnuLastOfflineOID         string `mapstructure:"onu_last_offline_time"`
-	OnuLastOfflineReasonOID   string `mapstructure:"onu_last_offline_reason"`
-	OnuGponOpticalDistanceOID string `mapstructure:"onu_gpon_optical_distance"`
+	OnuIDNameOID                 string `mapstructure:"onu_id_name"`
+	OnuTypeOID                   string `mapstructure:"onu_type"`
+	OnuSerialNumberOID           string `mapstructure:"onu_serial_number"`
+	OnuRxPowerOID                string `mapstructure:"onu_rx_power"`
+	OnuTxPowerOID                string `mapstructure:"onu_tx_power"`
+	OnuStatusOID                 string `mapstructure:"onu_status_id"`
+	OnuIPAddressOID              string `mapstructure:"onu_ip_address"`
+	OnuDescriptionOID            string `mapstructure:"onu_description"`
+	OnuLastOnlineOID             string `mapstructure:"onu_last_online_time"`
+	OnuLastOfflineOID            string `mapstructure:"onu_last_offline_time"`
+	OnuLastOfflineReasonOID      string `mapstructure:"onu_last_offline_reason"`
+	OnuGponOpticalDistanceOID    string `mapstructure:"onu_gpon_optical_distance"`
+	OnuUniAdminStateOID          string `mapstructure:"onu_uni_admin_state"`
+	OnuUniLinkStateOID           string `mapstructure:"onu_uni_link_state"`
+	OnuUniSpeedOID               string `mapstructure:"onu_uni_speed"`
+	OnuUniDuplexOID              string `mapstructure:"onu_uni_duplex"`
+	OnuHardwareVersionOID        string `mapstructure:"onu_hardware_version"`
+	OnuSoftwareVersionActiveOID  string `mapstructure:"onu_software_version_active"`
+	OnuSoftwareVersionStandbyOID string `mapstructure:"onu_software_version_standby"`
+	OnuEquipmentIDOID            string `mapstructure:"onu_equipment_id"`
+	OnuUpstreamBytesOID          string `mapstructure:"onu_upstream_bytes"`
+	OnuDownstreamBytesOID        string `mapstructure:"onu_downstream_bytes"`
+	OnuUpstreamPacketsOID        string `mapstructure:"onu_upstream_packets"`
+	OnuDownstreamPacketsOID      string `mapstructure:"onu_downstream_packets"`
+	OnuOltRxPowerOID             string `mapstructure:"onu_olt_rx_power"`
+	OnuTemperatureOID            string `mapstructure:"onu_temperature"`
+	OnuVoltageOID                string `mapstructure:"onu_voltage"`
+	OnuBiasCurrentOID            string `mapstructure:"onu_bias_current"`
 }
 
 // LoadConfig file from given path using viper
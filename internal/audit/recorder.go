@@ -0,0 +1,157 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/middleware"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/repository"
+	"github.com/rs/zerolog/log"
+)
+
+// Audited actions
+const (
+	ActionUpdateEmptyOnuID = "update_empty_onu_id"
+)
+
+// entryContextKey is the context key of the audit entry of a request
+type entryContextKey struct{}
+
+// Recorder records the write and administrative operations in the append-only audit log and queries it
+type Recorder struct {
+	repo repository.AuditRepositoryInterface
+}
+
+// NewRecorder creates a new Recorder.
+func NewRecorder(repo repository.AuditRepositoryInterface) *Recorder {
+	return &Recorder{repo: repo}
+}
+
+// Middleware records an audit entry of the action for every request of the route once the handler returns, with
+// the actor, the target board, PON and ONU of the URL, the request ID and the result. The handler adds the before
+// and after values with SetChange and the error of a failed operation with SetError. It must run after the
+// authentication of the route group and before the role check of the route, so refused attempts are recorded too.
+func (rec *Recorder) Middleware(action string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			entry := &model.AuditEntry{
+				Time:       time.Now(),
+				RequestID:  chimiddleware.GetReqID(r.Context()),
+				RemoteAddr: r.RemoteAddr,
+				Action:     action,
+				Board:      urlParamInt(r, "board_id"),
+				PON:        urlParamInt(r, "pon_id"),
+				OnuID:      urlParamInt(r, "onu_id"),
+			}
+			if apiKey, ok := middleware.APIKeyFromContext(r.Context()); ok {
+				entry.Actor = apiKey.Name
+				entry.Role = apiKey.Role
+			} else if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+				entry.Actor = host
+			} else {
+				entry.Actor = r.RemoteAddr
+			}
+
+			ww := chimiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r.WithContext(context.WithValue(r.Context(), entryContextKey{}, entry)))
+
+			entry.StatusCode = ww.Status()
+			entry.Result = model.AuditResultSuccess
+			if entry.StatusCode >= http.StatusBadRequest {
+				entry.Result = model.AuditResultFailure
+			}
+
+			// The operation is done, record it even when the client is gone
+			if err := rec.repo.Append(context.WithoutCancel(r.Context()), *entry); err != nil {
+				log.Error().Err(err).Str("action", action).Str("actor", entry.Actor).
+					Str("request_id", entry.RequestID).Msg("Failed to record audit entry")
+			}
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+// Query returns the newest entries matching the filter first, at most limit entries
+func (rec *Recorder) Query(ctx context.Context, filter model.AuditFilter, limit int) ([]model.AuditEntry, error) {
+	// Keep the last limit matching entries in a ring
+	ring := make([]model.AuditEntry, 0, limit)
+	next := 0
+	err := rec.repo.Scan(ctx, func(entry model.AuditEntry) error {
+		if !filter.Match(entry) {
+			return nil
+		}
+		if len(ring) < limit {
+			ring = append(ring, entry)
+		} else if limit > 0 {
+			ring[next] = entry
+			next = (next + 1) % limit
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]model.AuditEntry, 0, len(ring))
+	for i := len(ring) - 1; i >= 0; i-- {
+		entries = append(entries, ring[(next+i)%len(ring)])
+	}
+
+	return entries, nil
+}
+
+// Export calls fn with every entry matching the filter, oldest first
+func (rec *Recorder) Export(ctx context.Context, filter model.AuditFilter, fn func(entry model.AuditEntry) error) error {
+	return rec.repo.Scan(ctx, func(entry model.AuditEntry) error {
+		if !filter.Match(entry) {
+			return nil
+		}
+		return fn(entry)
+	})
+}
+
+// SetChange records the values of the target before and after the operation of the request
+func SetChange(ctx context.Context, before, after interface{}) {
+	entry, ok := ctx.Value(entryContextKey{}).(*model.AuditEntry)
+	if !ok {
+		return
+	}
+	entry.Before = marshalValue(before)
+	entry.After = marshalValue(after)
+}
+
+// SetError records the SNMP or CLI error of a failed operation of the request
+func SetError(ctx context.Context, err error) {
+	entry, ok := ctx.Value(entryContextKey{}).(*model.AuditEntry)
+	if !ok || err == nil {
+		return
+	}
+	entry.Error = err.Error()
+}
+
+// marshalValue returns the JSON of a value, nil for a nil value
+func marshalValue(value interface{}) json.RawMessage {
+	if value == nil {
+		return nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to marshal audit value")
+		return nil
+	}
+	return data
+}
+
+// urlParamInt returns an integer URL parameter of the route, zero when it is missing or invalid
+func urlParamInt(r *http.Request, name string) int {
+	value, _ := strconv.Atoi(chi.URLParam(r, name))
+	return value
+}
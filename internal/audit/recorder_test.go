@@ -0,0 +1,140 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/middleware"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRecorder(t *testing.T) (*Recorder, string) {
+	path := filepath.Join(t.TempDir(), "audit", "audit.jsonl")
+	repo, err := repository.NewAuditFileRepo(path)
+	require.NoError(t, err)
+	return NewRecorder(repo), path
+}
+
+func TestMiddlewareRecordsEntry(t *testing.T) {
+	recorder, path := newTestRecorder(t)
+	auth := middleware.NewAuthenticator(config.AuthConfig{
+		Enabled: true,
+		APIKeys: []config.APIKeyConfig{
+			{Name: "noc", KeyHash: middleware.HashAPIKey("operator-key"), Role: model.RoleOperator},
+			{Name: "dashboard", KeyHash: middleware.HashAPIKey("viewer-key"), Role: model.RoleViewer},
+		},
+	}, nil)
+
+	router := chi.NewRouter()
+	router.Use(chimiddleware.RequestID)
+	router.Use(auth.RequireRole(model.RoleViewer))
+	router.With(recorder.Middleware(ActionUpdateEmptyOnuID), auth.RequireRole(model.RoleOperator)).
+		Get("/board/{board_id}/pon/{pon_id}/onu_id/update", func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("fail") != "" {
+				SetError(r.Context(), errors.New("failed to perform SNMP Walk"))
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			SetChange(r.Context(), []model.OnuID{{Board: 1, PON: 8, ID: 3}}, []model.OnuID{})
+		})
+
+	request := func(target, key string) int {
+		r := httptest.NewRequest(http.MethodGet, target, nil)
+		r.Header.Set(middleware.APIKeyHeader, key)
+		r.Header.Set(chimiddleware.RequestIDHeader, "req-1")
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, r)
+		return recorder.Code
+	}
+
+	assert.Equal(t, http.StatusOK, request("/board/1/pon/8/onu_id/update", "operator-key"))
+	assert.Equal(t, http.StatusInternalServerError, request("/board/1/pon/8/onu_id/update?fail=1", "operator-key"))
+	assert.Equal(t, http.StatusForbidden, request("/board/2/pon/3/onu_id/update", "viewer-key"))
+
+	entries, err := recorder.Query(context.Background(), model.AuditFilter{}, 10)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	// Newest first, the refused attempt of the viewer is recorded too
+	assert.Equal(t, "dashboard", entries[0].Actor)
+	assert.Equal(t, model.AuditResultFailure, entries[0].Result)
+	assert.Equal(t, http.StatusForbidden, entries[0].StatusCode)
+	assert.Equal(t, 2, entries[0].Board)
+	assert.Equal(t, 3, entries[0].PON)
+
+	assert.Equal(t, "failed to perform SNMP Walk", entries[1].Error)
+	assert.Equal(t, model.AuditResultFailure, entries[1].Result)
+
+	assert.Equal(t, "noc", entries[2].Actor)
+	assert.Equal(t, model.RoleOperator, entries[2].Role)
+	assert.Equal(t, ActionUpdateEmptyOnuID, entries[2].Action)
+	assert.Equal(t, "req-1", entries[2].RequestID)
+	assert.Equal(t, 1, entries[2].Board)
+	assert.Equal(t, 8, entries[2].PON)
+	assert.Equal(t, model.AuditResultSuccess, entries[2].Result)
+	assert.JSONEq(t, `[{"board":1,"pon":8,"onu_id":3}]`, string(entries[2].Before))
+	assert.JSONEq(t, `[]`, string(entries[2].After))
+
+	// The log is one JSON entry per line
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 3, strings.Count(string(data), "\n"))
+}
+
+func TestQueryAndExport(t *testing.T) {
+	recorder, path := newTestRecorder(t)
+	repo, err := repository.NewAuditFileRepo(path)
+	require.NoError(t, err)
+
+	start := time.Date(2024, 6, 15, 10, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		actor := "noc"
+		if i%2 == 1 {
+			actor = "script"
+		}
+		require.NoError(t, repo.Append(context.Background(), model.AuditEntry{
+			Time: start.Add(time.Duration(i) * time.Minute), Actor: actor, Action: ActionUpdateEmptyOnuID,
+			Board: 1, PON: i + 1, Result: model.AuditResultSuccess,
+		}))
+	}
+
+	// A line cut by a crash is skipped
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	require.NoError(t, err)
+	_, _ = file.WriteString("{\"time\":\"2024-06\n")
+	_ = file.Close()
+
+	entries, err := recorder.Query(context.Background(), model.AuditFilter{Actor: "noc"}, 2)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, 5, entries[0].PON)
+	assert.Equal(t, 3, entries[1].PON)
+
+	entries, err = recorder.Query(context.Background(),
+		model.AuditFilter{From: start.Add(time.Minute), To: start.Add(3 * time.Minute)}, 100)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, 3, entries[0].PON)
+	assert.Equal(t, 2, entries[1].PON)
+
+	var exported []int
+	err = recorder.Export(context.Background(), model.AuditFilter{Actor: "script"}, func(entry model.AuditEntry) error {
+		exported = append(exported, entry.PON)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{2, 4}, exported)
+}
@@ -0,0 +1,149 @@
+package events
+
+import (
+	"sync"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+)
+
+// Event types
+const (
+	TypeOnuRegistered    = "onu_registered"
+	TypeOnuRemoved       = "onu_removed"
+	TypeStatusChanged    = "status_changed"
+	TypeRxPowerLow       = "rx_power_low"
+	TypeRxPowerRecovered = "rx_power_recovered"
+)
+
+const (
+	defaultBufferSize     = 1000
+	subscriberChannelSize = 64
+)
+
+// Filter selects the events of a board and PON, zero matches every board or PON
+type Filter struct {
+	Board int
+	PON   int
+}
+
+// Match returns true when the event belongs to the filtered board and PON
+func (f Filter) Match(event model.OnuEvent) bool {
+	return (f.Board == 0 || f.Board == event.Board) && (f.PON == 0 || f.PON == event.PON)
+}
+
+// subscriber is a client receiving the published events that match its filter
+type subscriber struct {
+	filter Filter
+	events chan model.OnuEvent
+}
+
+// Broker fans out the published ONU events to the subscribers and keeps the last events in a bounded buffer,
+// so a client that reconnects with the ID of the last event it received gets the events it missed
+type Broker struct {
+	mu          sync.Mutex
+	buffer      []model.OnuEvent // ring buffer, oldest event at start once full
+	start       int
+	lastID      uint64
+	subscribers map[*subscriber]struct{}
+	closed      bool
+}
+
+// NewBroker will create a broker keeping the last bufferSize events, zero or less uses the default of 1000
+func NewBroker(bufferSize int) *Broker {
+	if bufferSize <= 0 {
+		bufferSize = defaultBufferSize
+	}
+	return &Broker{
+		buffer:      make([]model.OnuEvent, 0, bufferSize),
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// Publish gives every event the next event ID, stores it in the buffer and sends it to the matching subscribers.
+// A subscriber that does not keep up is disconnected, it can resume from the buffer with its last event ID.
+func (b *Broker) Publish(events ...model.OnuEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	for _, event := range events {
+		b.lastID++
+		event.ID = b.lastID
+
+		if len(b.buffer) < cap(b.buffer) {
+			b.buffer = append(b.buffer, event)
+		} else {
+			b.buffer[b.start] = event
+			b.start = (b.start + 1) % len(b.buffer)
+		}
+
+		for sub := range b.subscribers {
+			if !sub.filter.Match(event) {
+				continue
+			}
+			select {
+			case sub.events <- event:
+			default:
+				delete(b.subscribers, sub)
+				close(sub.events)
+			}
+		}
+	}
+}
+
+// Subscribe registers a subscriber for the events matching the filter. When lastEventID is not zero, the buffered
+// events published after it are returned as backlog, or every buffered event when lastEventID is not known
+// anymore. The events channel is closed when the subscriber is too slow or the broker is closed, unsubscribe
+// must be called when the client is gone.
+func (b *Broker) Subscribe(filter Filter, lastEventID uint64) (
+	backlog []model.OnuEvent, events <-chan model.OnuEvent, unsubscribe func(),
+) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if lastEventID != 0 {
+		// An ID newer than the last event comes from before a restart, replay the whole buffer
+		if lastEventID > b.lastID {
+			lastEventID = 0
+		}
+		for i := 0; i < len(b.buffer); i++ {
+			event := b.buffer[(b.start+i)%len(b.buffer)]
+			if event.ID > lastEventID && filter.Match(event) {
+				backlog = append(backlog, event)
+			}
+		}
+	}
+
+	sub := &subscriber{filter: filter, events: make(chan model.OnuEvent, subscriberChannelSize)}
+	if b.closed {
+		close(sub.events)
+		return backlog, sub.events, func() {}
+	}
+	b.subscribers[sub] = struct{}{}
+
+	unsubscribe = func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.subscribers[sub]; ok {
+			delete(b.subscribers, sub)
+			close(sub.events)
+		}
+	}
+
+	return backlog, sub.events, unsubscribe
+}
+
+// Close disconnects every subscriber, events published afterward are dropped
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for sub := range b.subscribers {
+		delete(b.subscribers, sub)
+		close(sub.events)
+	}
+}
@@ -0,0 +1,95 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func eventIDs(events []model.OnuEvent) []uint64 {
+	ids := make([]uint64, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+	return ids
+}
+
+func TestBrokerPublishAndFilter(t *testing.T) {
+	broker := NewBroker(10)
+
+	_, board1, unsubscribe1 := broker.Subscribe(Filter{Board: 1}, 0)
+	defer unsubscribe1()
+	_, board2Pon3, unsubscribe2 := broker.Subscribe(Filter{Board: 2, PON: 3}, 0)
+	defer unsubscribe2()
+
+	broker.Publish(
+		model.OnuEvent{Board: 1, PON: 1, OnuID: 1},
+		model.OnuEvent{Board: 2, PON: 3, OnuID: 2},
+		model.OnuEvent{Board: 2, PON: 4, OnuID: 3},
+	)
+
+	assert.Equal(t, uint64(1), (<-board1).ID)
+	assert.Equal(t, uint64(2), (<-board2Pon3).ID)
+	assert.Empty(t, board1)
+	assert.Empty(t, board2Pon3)
+}
+
+func TestBrokerResume(t *testing.T) {
+	broker := NewBroker(3)
+	for i := 0; i < 5; i++ {
+		broker.Publish(model.OnuEvent{Board: 1, PON: 1, OnuID: i + 1})
+	}
+
+	tests := []struct {
+		name        string
+		lastEventID uint64
+		expected    []uint64
+	}{
+		{"No last event ID", 0, []uint64{}},
+		{"Resume inside the buffer", 3, []uint64{4, 5}},
+		{"Up to date", 5, []uint64{}},
+		{"Older than the buffer", 1, []uint64{3, 4, 5}},
+		{"Unknown ID after a restart", 42, []uint64{3, 4, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backlog, _, unsubscribe := broker.Subscribe(Filter{}, tt.lastEventID)
+			defer unsubscribe()
+			assert.Equal(t, tt.expected, eventIDs(backlog))
+		})
+	}
+}
+
+func TestBrokerDisconnectsSlowSubscriber(t *testing.T) {
+	broker := NewBroker(0)
+	_, events, unsubscribe := broker.Subscribe(Filter{}, 0)
+
+	for i := 0; i <= subscriberChannelSize; i++ {
+		broker.Publish(model.OnuEvent{Board: 1, PON: 1, OnuID: 1})
+	}
+
+	received := 0
+	for range events {
+		received++
+	}
+	assert.Equal(t, subscriberChannelSize, received)
+
+	// Unsubscribing after the broker closed the channel must not panic
+	unsubscribe()
+}
+
+func TestBrokerClose(t *testing.T) {
+	broker := NewBroker(0)
+	_, events, unsubscribe := broker.Subscribe(Filter{}, 0)
+	defer unsubscribe()
+
+	broker.Close()
+	_, ok := <-events
+	assert.False(t, ok)
+
+	_, events, _ = broker.Subscribe(Filter{}, 0)
+	_, ok = <-events
+	assert.False(t, ok)
+}
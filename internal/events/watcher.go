@@ -0,0 +1,178 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/usecase"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/utils"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultPollInterval     = 30 * time.Second
+	defaultRxPowerThreshold = -27.0
+
+	// maxValidPower is the exclusive upper bound of a valid optical power reading in dBm
+	maxValidPower = 100
+)
+
+// ponKey identifies a PON of a board
+type ponKey struct {
+	board int
+	pon   int
+}
+
+// StateSink receives the ONU list of every PON that was polled successfully, e.g. to publish the current state of
+// the ONUs next to their events
+type StateSink interface {
+	WriteState(ctx context.Context, boardID, ponID int, onuInfoList []model.ONUInfoPerBoard)
+}
+
+// Watcher polls the ONU list of every PON and publishes the changes between two polls to the broker. The status
+// and rx power are walked from the OLT on every poll, the other fields come from the cached PON lists.
+type Watcher struct {
+	onuUsecase       usecase.OnuUseCaseInterface
+	broker           *Broker
+	interval         time.Duration
+	rxPowerThreshold float64
+	state            map[ponKey]map[int]model.ONUInfoPerBoard
+	sinks            []StateSink
+}
+
+// NewWatcher creates a new Watcher, the zero values of cfg use the defaults.
+func NewWatcher(onuUsecase usecase.OnuUseCaseInterface, broker *Broker, cfg config.EventsConfig) *Watcher {
+	interval := time.Duration(cfg.PollIntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	rxPowerThreshold := cfg.RxPowerThreshold
+	if rxPowerThreshold == 0 {
+		rxPowerThreshold = defaultRxPowerThreshold
+	}
+
+	return &Watcher{
+		onuUsecase:       onuUsecase,
+		broker:           broker,
+		interval:         interval,
+		rxPowerThreshold: rxPowerThreshold,
+		state:            make(map[ponKey]map[int]model.ONUInfoPerBoard),
+	}
+}
+
+// AddStateSink adds a sink for the ONU list of every polled PON, it must be called before Start.
+func (w *Watcher) AddStateSink(sink StateSink) {
+	w.sinks = append(w.sinks, sink)
+}
+
+// Start runs the watcher in a loop, the broker is closed when the context is done.
+func (w *Watcher) Start(ctx context.Context) {
+	log.Info().Msgf("ONU event watcher polls every %s with an rx power threshold of %.2f dBm", w.interval,
+		w.rxPowerThreshold)
+
+	// Run the poll loop.
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			w.poll(ctx)
+
+			select {
+			case <-ctx.Done():
+				w.broker.Close()
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// poll reads the ONU list of every PON and publishes the changes since the previous poll. The first successful
+// poll of a PON only records its state.
+func (w *Watcher) poll(ctx context.Context) {
+	for boardID := 1; boardID <= 2; boardID++ {
+		for ponID := 1; ponID <= 16; ponID++ {
+			onuInfoList, err := w.onuUsecase.GetOnuStatusList(ctx, boardID, ponID)
+			if err != nil {
+				// Keep the previous state, a failed poll must not look like removed ONUs
+				log.Warn().Err(err).Int("board", boardID).Int("pon", ponID).Msg("Failed to poll ONUs for events")
+				continue
+			}
+
+			// The state is written before the events, so a client reacting to an event finds the new state
+			for _, sink := range w.sinks {
+				sink.WriteState(ctx, boardID, ponID, onuInfoList)
+			}
+
+			current := make(map[int]model.ONUInfoPerBoard, len(onuInfoList))
+			for _, onuInfo := range onuInfoList {
+				current[onuInfo.ID] = onuInfo
+			}
+
+			key := ponKey{board: boardID, pon: ponID}
+			if previous, ok := w.state[key]; ok {
+				w.broker.Publish(DiffOnuList(previous, current, w.rxPowerThreshold, time.Now())...)
+			}
+			w.state[key] = current
+		}
+	}
+}
+
+// DiffOnuList returns the events between two ONU lists of a PON, keyed by ONU ID, ordered by ONU ID.
+// An ONU crossing the rx power threshold gets an rx_power_low or rx_power_recovered event, readings that are
+// missing on either poll are ignored.
+func DiffOnuList(
+	previous, current map[int]model.ONUInfoPerBoard, rxPowerThreshold float64, now time.Time,
+) []model.OnuEvent {
+	var events []model.OnuEvent
+
+	for onuID := 1; onuID <= 128; onuID++ {
+		before, existed := previous[onuID]
+		after, exists := current[onuID]
+
+		switch {
+		case exists && !existed:
+			events = append(events, newOnuEvent(TypeOnuRegistered, after, before, now))
+		case existed && !exists:
+			events = append(events, newOnuEvent(TypeOnuRemoved, before, model.ONUInfoPerBoard{}, now))
+		case exists:
+			if after.Status != before.Status {
+				events = append(events, newOnuEvent(TypeStatusChanged, after, before, now))
+			}
+
+			powerBefore := utils.ParseFloatOrNil(before.RXPower, maxValidPower)
+			powerAfter := utils.ParseFloatOrNil(after.RXPower, maxValidPower)
+			if powerBefore == nil || powerAfter == nil {
+				continue
+			}
+			if *powerBefore >= rxPowerThreshold && *powerAfter < rxPowerThreshold {
+				events = append(events, newOnuEvent(TypeRxPowerLow, after, before, now))
+			}
+			if *powerBefore < rxPowerThreshold && *powerAfter >= rxPowerThreshold {
+				events = append(events, newOnuEvent(TypeRxPowerRecovered, after, before, now))
+			}
+		}
+	}
+
+	return events
+}
+
+// newOnuEvent creates the event of an ONU, before is the ONU on the previous poll or empty when it is not known
+func newOnuEvent(eventType string, onuInfo, before model.ONUInfoPerBoard, now time.Time) model.OnuEvent {
+	return model.OnuEvent{
+		Type:            eventType,
+		Board:           onuInfo.Board,
+		PON:             onuInfo.PON,
+		OnuID:           onuInfo.ID,
+		Name:            onuInfo.Name,
+		SerialNumber:    onuInfo.SerialNumber,
+		Status:          onuInfo.Status,
+		PreviousStatus:  before.Status,
+		RXPower:         onuInfo.RXPower,
+		PreviousRXPower: before.RXPower,
+		Time:            now,
+	}
+}
@@ -0,0 +1,103 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeOnuUsecase serves one ONU on board 1 PON 1, the other PONs fail to poll
+type fakeOnuUsecase struct {
+	usecase.OnuUseCaseInterface
+	status string
+}
+
+func (f *fakeOnuUsecase) GetOnuStatusList(_ context.Context, boardID, ponID int) ([]model.ONUInfoPerBoard, error) {
+	if boardID != 1 || ponID != 1 {
+		return nil, errors.New("request timeout")
+	}
+	return []model.ONUInfoPerBoard{{Board: 1, PON: 1, ID: 3, Status: f.status}}, nil
+}
+
+// fakeStateSink records the ONU lists written to it
+type fakeStateSink struct {
+	states [][]model.ONUInfoPerBoard
+}
+
+func (f *fakeStateSink) WriteState(_ context.Context, _, _ int, onuInfoList []model.ONUInfoPerBoard) {
+	f.states = append(f.states, onuInfoList)
+}
+
+func TestDiffOnuList(t *testing.T) {
+	previous := map[int]model.ONUInfoPerBoard{
+		1: {ID: 1, Status: "Online", RXPower: "-20.10"},
+		2: {ID: 2, Status: "Online", RXPower: "-26.50"},
+		3: {ID: 3, Status: "Online", RXPower: "-28.00"},
+		4: {ID: 4, Status: "Online", RXPower: "-21.00"},
+		5: {ID: 5, Status: "LOS", RXPower: ""},
+	}
+	current := map[int]model.ONUInfoPerBoard{
+		1: {ID: 1, Status: "Online", RXPower: "-20.30"},
+		2: {ID: 2, Status: "Online", RXPower: "-27.40"},
+		3: {ID: 3, Status: "Online", RXPower: "-26.90"},
+		5: {ID: 5, Status: "Online", RXPower: "-22.00"},
+		6: {ID: 6, Status: "Online", RXPower: "-19.00"},
+	}
+
+	type change struct {
+		eventType      string
+		onuID          int
+		previousStatus string
+	}
+	var changes []change
+	for _, event := range DiffOnuList(previous, current, -27, time.Now()) {
+		changes = append(changes, change{event.Type, event.OnuID, event.PreviousStatus})
+	}
+
+	assert.Equal(t, []change{
+		{TypeRxPowerLow, 2, "Online"},
+		{TypeRxPowerRecovered, 3, "Online"},
+		{TypeOnuRemoved, 4, ""},
+		{TypeStatusChanged, 5, "LOS"},
+		{TypeOnuRegistered, 6, ""},
+	}, changes)
+}
+
+func TestWatcherWritesPolledStates(t *testing.T) {
+	fake := &fakeOnuUsecase{status: "Online"}
+	broker := NewBroker(10)
+	sink := &fakeStateSink{}
+	w := NewWatcher(fake, broker, config.EventsConfig{})
+	w.AddStateSink(sink)
+
+	// Only the PON that was polled successfully is written, the first poll has no events
+	w.poll(context.Background())
+	assert.Len(t, sink.states, 1)
+
+	fake.status = "LOS"
+	w.poll(context.Background())
+	assert.Len(t, sink.states, 2)
+	assert.Equal(t, "LOS", sink.states[1][0].Status)
+
+	// An unknown last event ID replays the whole buffer
+	backlog, _, unsubscribe := broker.Subscribe(Filter{}, 1000)
+	defer unsubscribe()
+	assert.Len(t, backlog, 1)
+	assert.Equal(t, TypeStatusChanged, backlog[0].Type)
+}
+
+func TestNewWatcherDefaults(t *testing.T) {
+	w := NewWatcher(&fakeOnuUsecase{}, NewBroker(0), config.EventsConfig{})
+	assert.Equal(t, defaultPollInterval, w.interval)
+	assert.Equal(t, defaultRxPowerThreshold, w.rxPowerThreshold)
+
+	w = NewWatcher(&fakeOnuUsecase{}, NewBroker(0), config.EventsConfig{PollIntervalSeconds: 10, RxPowerThreshold: -25})
+	assert.Equal(t, 10*time.Second, w.interval)
+	assert.Equal(t, -25.0, w.rxPowerThreshold)
+}
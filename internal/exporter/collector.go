@@ -3,18 +3,81 @@ package exporter
 import (
 	"context"
 	"fmt"
-	"os"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/tracing"
 	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/usecase"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/utils"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
 )
 
-// OnuCollector is a struct that holds the use case for fetching ONU data.
+// defaultRxPowerThreshold is the rx power in dBm below which an ONU counts towards zte_pon_rx_power_below_threshold_onus.
+const defaultRxPowerThreshold = -27.0
+
+// OnuCollector is a prometheus.Collector that serves ONU metrics from the latest complete snapshot.
+// Polling the OLT takes a long time, so a background scheduler builds the next snapshot with a pool of
+// workers and swaps it in only when it is complete. A scrape never sees a half-filled set of metrics.
 type OnuCollector struct {
-	onuUsecase usecase.OnuUseCaseInterface
+	onuUsecase       usecase.OnuUseCaseInterface
+	snapshot         atomic.Pointer[snapshot]
+	pons             []ponKey
+	interval         time.Duration
+	jitter           time.Duration
+	workers          int
+	overrunPolicy    string
+	skippedCycles    atomic.Uint64
+	rxPowerThreshold float64
+	infoLabels       []string
+	infoDesc         *prometheus.Desc
+	sinks            []Sink
+	sinkQueue        chan []model.Measurement
+	now              func() time.Time
+}
+
+// onuSeries holds the metrics and measurements of a single ONU in a snapshot.
+type onuSeries struct {
+	onu             []prometheus.Metric
+	uni             []prometheus.Metric
+	measurement     model.Measurement
+	uniMeasurements []model.Measurement
+	opticalDistance *float64
+	rxPower         *float64 // Rx power of an Online ONU with a valid reading, the PON aggregates use it
+}
+
+// ponSeries holds the aggregate metrics and measurement of a single PON in a snapshot.
+type ponSeries struct {
+	metrics     []prometheus.Metric
+	measurement model.Measurement
+}
+
+// ponKey identifies a PON of a board.
+type ponKey struct {
+	board, pon int
+}
+
+// ponResult is the outcome of collecting a single PON.
+type ponResult struct {
+	key      ponKey
+	onus     map[model.OnuID]onuSeries
+	pon      *ponSeries
+	failures int
+}
+
+// snapshot is the set of ONU and PON metrics built by a single collection run.
+type snapshot struct {
+	onus       map[model.OnuID]onuSeries
+	pons       map[ponKey]ponSeries
+	finishedAt time.Time
+	duration   time.Duration
+	success    bool
 }
 
 // --- Helper functions for parsing ---
@@ -29,10 +92,10 @@ func parseDurationStringToSeconds(durationStr string) float64 {
 	return float64(totalSeconds)
 }
 
-// parseTimestampStringToEpoch converts a timestamp string (YYYY-MM-DD HH:MM:SS) to a Unix epoch.
-func parseTimestampStringToEpoch(timestampStr string) float64 {
+// parseTimestampStringToEpoch converts a timestamp string (YYYY-MM-DD HH:MM:SS) on the OLT clock to a Unix epoch.
+func parseTimestampStringToEpoch(timestampStr string, oltLocation *time.Location) float64 {
 	layout := "2006-01-02 15:04:05"
-	t, err := time.Parse(layout, timestampStr)
+	t, err := time.ParseInLocation(layout, timestampStr, oltLocation)
 	if err != nil {
 		log.Warn().Err(err).Str("timestamp", timestampStr).Msg("Could not parse timestamp string")
 		return 0
@@ -40,140 +103,398 @@ func parseTimestampStringToEpoch(timestampStr string) float64 {
 	return float64(t.Unix())
 }
 
-// NewOnuCollector creates a new OnuCollector.
-func NewOnuCollector(onuUsecase usecase.OnuUseCaseInterface) *OnuCollector {
-	return &OnuCollector{onuUsecase: onuUsecase}
+// parseUniSpeedToMbps converts a UNI speed string like "100M" or "10G" to Mbit/s.
+func parseUniSpeedToMbps(speedStr string) float64 {
+	if strings.HasSuffix(speedStr, "G") {
+		if value, err := strconv.ParseFloat(strings.TrimSuffix(speedStr, "G"), 64); err == nil {
+			return value * 1000
+		}
+	}
+	if value, err := strconv.ParseFloat(strings.TrimSuffix(speedStr, "M"), 64); err == nil {
+		return value
+	}
+	return 0
 }
 
-// Start runs the collector in a loop to periodically fetch data.
-func (c *OnuCollector) Start(ctx context.Context) {
-	// Get scan range from environment variables or use defaults.
-	boardMin, _ := strconv.Atoi(os.Getenv("PROMETHEUS_BOARD_MIN"))
-	boardMax, _ := strconv.Atoi(os.Getenv("PROMETHEUS_BOARD_MAX"))
-	ponMin, _ := strconv.Atoi(os.Getenv("PROMETHEUS_PON_MIN"))
-	ponMax, _ := strconv.Atoi(os.Getenv("PROMETHEUS_PON_MAX"))
+// appendMetric appends a constant metric to metrics. Invalid label values, for example an ONU name that
+// is not valid UTF-8, are logged and the metric is skipped.
+func appendMetric(metrics []prometheus.Metric, desc *prometheus.Desc, valueType prometheus.ValueType, value float64, labels ...string) []prometheus.Metric {
+	metric, err := prometheus.NewConstMetric(desc, valueType, value, labels...)
+	if err != nil {
+		log.Warn().Err(err).Strs("labels", labels).Msg("Could not create ONU metric")
+		return metrics
+	}
+	return append(metrics, metric)
+}
 
-	// Set default values if not provided.
-	if boardMin == 0 {
-		boardMin = 1
+// boolToFloat converts a boolean to the 1/0 value used by state gauges.
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
 	}
-	if boardMax == 0 {
-		boardMax = 2
+	return 0
+}
+
+// NewOnuCollector creates a new OnuCollector with the schedule, thresholds and zte_onu_info labels of cfg.
+// It has to be registered with Prometheus and started with Start before it reports any ONU.
+func NewOnuCollector(onuUsecase usecase.OnuUseCaseInterface, cfg config.ExporterConfig) *OnuCollector {
+	c := &OnuCollector{
+		onuUsecase:       onuUsecase,
+		pons:             schedulePons(cfg.Ranges),
+		interval:         time.Duration(cfg.IntervalSeconds) * time.Second,
+		jitter:           time.Duration(cfg.JitterSeconds) * time.Second,
+		workers:          cfg.Workers,
+		overrunPolicy:    cfg.OverrunPolicy,
+		rxPowerThreshold: cfg.RxPowerThreshold,
+		infoLabels:       normalizeInfoLabels(cfg.InfoLabels),
+		sinkQueue:        make(chan []model.Measurement, 1),
+		now:              time.Now,
+	}
+	c.infoDesc = newOnuInfoDesc(c.infoLabels)
+
+	// Fall back to the defaults for the values that are not set
+	if c.interval <= 0 {
+		c.interval = defaultInterval
 	}
-	if ponMin == 0 {
-		ponMin = 1
+	if c.jitter < 0 || c.jitter >= c.interval {
+		log.Warn().Dur("jitter", c.jitter).Msg("Exporter jitter must be shorter than the interval, starting cycles without jitter")
+		c.jitter = 0
 	}
-	if ponMax == 0 {
-		ponMax = 16
+	if c.workers <= 0 {
+		c.workers = defaultWorkers
+	}
+	if c.overrunPolicy != OverrunPolicySkip && c.overrunPolicy != OverrunPolicyQueue {
+		if c.overrunPolicy != "" {
+			log.Warn().Str("overrun_policy", c.overrunPolicy).Msg("Unknown exporter overrun policy, using skip")
+		}
+		c.overrunPolicy = OverrunPolicySkip
+	}
+	if c.rxPowerThreshold == 0 {
+		c.rxPowerThreshold = defaultRxPowerThreshold
+	}
+
+	return c
+}
+
+// Describe implements prometheus.Collector.
+func (c *OnuCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.infoDesc
+	ch <- onuStatusDesc
+	ch <- onuLastOfflineReasonDesc
+	ch <- onuRxPowerDesc
+	ch <- onuTxPowerDesc
+	ch <- onuOltRxPowerDesc
+	ch <- onuTemperatureDesc
+	ch <- onuVoltageDesc
+	ch <- onuBiasCurrentDesc
+	ch <- onuUptimeDesc
+	ch <- onuLastDownDurationDesc
+	ch <- onuLastOnlineDesc
+	ch <- onuLastOfflineDesc
+	ch <- onuGponOpticalDistanceDesc
+	ch <- onuUniLinkUpDesc
+	ch <- onuUniAdminUpDesc
+	ch <- onuUniSpeedDesc
+	ch <- onuUpstreamBytesDesc
+	ch <- onuDownstreamBytesDesc
+	ch <- onuUpstreamPacketsDesc
+	ch <- onuDownstreamPacketsDesc
+	ch <- ponOnusDesc
+	ch <- ponRegisteredOnusDesc
+	ch <- ponOnuCapacityDesc
+	ch <- ponFreeOnuSlotsDesc
+	ch <- ponRxPowerMinDesc
+	ch <- ponRxPowerAvgDesc
+	ch <- ponRxPowerP10Desc
+	ch <- ponRxPowerBelowThresholdDesc
+	ch <- ponGponOpticalDistanceAvgDesc
+	ch <- snapshotAgeDesc
+	ch <- lastCollectionSuccessDesc
+	ch <- lastCollectionDurationDesc
+	ch <- skippedCyclesDesc
+}
+
+// Collect implements prometheus.Collector. It emits the latest complete snapshot, only the skipped cycle
+// counter is reported until the first collection has finished.
+func (c *OnuCollector) Collect(ch chan<- prometheus.Metric) {
+	ch <- prometheus.MustNewConstMetric(skippedCyclesDesc, prometheus.CounterValue, float64(c.skippedCycles.Load()))
+
+	s := c.snapshot.Load()
+	if s == nil {
+		return
+	}
+
+	for _, series := range s.onus {
+		for _, metric := range series.onu {
+			ch <- metric
+		}
+		for _, metric := range series.uni {
+			ch <- metric
+		}
+	}
+	for _, series := range s.pons {
+		for _, metric := range series.metrics {
+			ch <- metric
+		}
+	}
+
+	ch <- prometheus.MustNewConstMetric(snapshotAgeDesc, prometheus.GaugeValue, c.now().Sub(s.finishedAt).Seconds())
+	ch <- prometheus.MustNewConstMetric(lastCollectionSuccessDesc, prometheus.GaugeValue, boolToFloat(s.success))
+	ch <- prometheus.MustNewConstMetric(lastCollectionDurationDesc, prometheus.GaugeValue, s.duration.Seconds())
+}
+
+// collect performs a single run of the data collection and publishes the result as the new snapshot.
+// The PONs are collected by a pool of workers. An ONU or PON that could not be read keeps its metrics
+// from the previous snapshot, so a single SNMP timeout does not make series disappear, and the run is
+// reported as failed.
+func (c *OnuCollector) collect(ctx context.Context) {
+	ctx = usecase.WithTrafficConsumer(ctx, usecase.TrafficConsumerExporter)
+	started := c.now()
+	previous := c.snapshot.Load()
+	if previous == nil {
+		previous = &snapshot{}
+	}
+
+	jobs := make(chan ponKey)
+	results := make(chan ponResult)
+
+	var wg sync.WaitGroup
+	for i := 0; i < c.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range jobs {
+				results <- c.collectPon(ctx, key, previous)
+			}
+		}()
 	}
 
-	// Run the collection loop.
 	go func() {
-		for {
+		defer close(jobs)
+		for _, key := range c.pons {
 			select {
+			case jobs <- key:
 			case <-ctx.Done():
 				return
-			default:
-				log.Info().Msg("Starting ONU discovery and data collection for Prometheus")
-				c.collect(ctx, boardMin, boardMax, ponMin, ponMax)
-				log.Info().Msg("Finished ONU data collection")
-				time.Sleep(30 * time.Second) // Collection interval
 			}
 		}
 	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	onus := make(map[model.OnuID]onuSeries, len(previous.onus))
+	pons := make(map[ponKey]ponSeries, len(c.pons))
+	failures := 0
+	for result := range results {
+		for onuID, series := range result.onus {
+			onus[onuID] = series
+		}
+		if result.pon != nil {
+			pons[result.key] = *result.pon
+		}
+		failures += result.failures
+	}
+
+	if ctx.Err() != nil {
+		return // Shutting down, the run may be incomplete.
+	}
+
+	finished := c.now()
+	s := &snapshot{
+		onus:       onus,
+		pons:       pons,
+		finishedAt: finished,
+		duration:   finished.Sub(started),
+		success:    failures == 0,
+	}
+	c.snapshot.Store(s)
+	c.publish(s)
+	if failures > 0 {
+		log.Warn().Int("failures", failures).Msg("ONU data collection finished with errors")
+	}
 }
 
-// collect performs a single run of the data collection.
-func (c *OnuCollector) collect(ctx context.Context, boardMin, boardMax, ponMin, ponMax int) {
-	// Reset gauges to remove old data to avoid reporting stale metrics.
-	OnuInfoGauge.Reset()
-	OnuRxPowerGauge.Reset()
-	OnuTxPowerGauge.Reset()
-	OnuUptimeGauge.Reset()
-	OnuLastDownDurationGauge.Reset()
-	OnuLastOnlineGauge.Reset()
-	OnuLastOfflineGauge.Reset()
-	OnuGponOpticalDistanceGauge.Reset()
-
-	for boardID := boardMin; boardID <= boardMax; boardID++ {
-		for ponID := ponMin; ponID <= ponMax; ponID++ {
-			// Discover active ONUs on the current board and PON.
-			discoveredOnus, err := c.onuUsecase.GetByBoardIDAndPonID(ctx, boardID, ponID)
-			if err != nil {
-				log.Warn().Err(err).Int("board", boardID).Int("pon", ponID).Msg("Failed to discover ONUs")
-				continue // Move to the next PON if discovery fails.
+// collectPon builds the ONU and aggregate metrics of a single PON.
+func (c *OnuCollector) collectPon(ctx context.Context, key ponKey, previous *snapshot) (result ponResult) {
+	// One trace per PON, the usecase, SNMP and Redis spans of its ONUs are its children
+	ctx, span := tracing.Start(ctx, "OnuCollector.collectPon",
+		attribute.Int("olt.board", key.board), attribute.Int("olt.pon", key.pon))
+	defer func() {
+		span.SetAttributes(attribute.Int("olt.onus", len(result.onus)), attribute.Int("exporter.failures", result.failures))
+		span.End()
+	}()
+
+	result = ponResult{key: key, onus: make(map[model.OnuID]onuSeries)}
+
+	// Discover active ONUs on the current board and PON.
+	discoveredOnus, err := c.onuUsecase.GetByBoardIDAndPonID(ctx, key.board, key.pon)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Warn().Err(err).Int("board", key.board).Int("pon", key.pon).Msg("Failed to discover ONUs")
+		}
+		result.failures++
+		// Keep the previous metrics of this PON.
+		for onuID, series := range previous.onus {
+			if onuID.Board == key.board && onuID.PON == key.pon {
+				result.onus[onuID] = series
 			}
+		}
+		if series, ok := previous.pons[key]; ok {
+			result.pon = &series
+		}
+		return result
+	}
+
+	// Fetch detailed information for each discovered ONU.
+	for _, discoveredOnu := range discoveredOnus {
+		if ctx.Err() != nil {
+			return result // Shutting down, the snapshot is not stored.
+		}
+
+		onuID := model.OnuID{Board: key.board, PON: key.pon, ID: discoveredOnu.ID}
+		series, ok := c.collectOnu(ctx, key.board, key.pon, discoveredOnu.ID, previous.onus[onuID])
+		if !ok {
+			result.failures++
+		}
+		if series.onu != nil {
+			result.onus[onuID] = series
+		}
+	}
+
+	// PONs without ONUs are still reported, their free slots matter for capacity planning.
+	series := ponMetrics(key.board, key.pon, discoveredOnus, result.onus, c.rxPowerThreshold, c.now())
+	result.pon = &series
+	return result
+}
+
+// collectOnu builds the metrics and measurements of a single ONU. When the ONU cannot be read the previous
+// series is returned and ok is false, an ONU that no longer exists gets an empty series.
+func (c *OnuCollector) collectOnu(ctx context.Context, boardID, ponID, onuID int, previous onuSeries) (series onuSeries, ok bool) {
+	detailedOnu, err := c.onuUsecase.GetByBoardIDPonIDAndOnuID(ctx, boardID, ponID, onuID)
+	if err != nil {
+		log.Warn().Err(err).Int("board", boardID).Int("pon", ponID).Int("onu_id", onuID).Msg("Failed to get detailed ONU info")
+		return previous, false
+	}
+
+	// The ONU was removed since the list was cached, it has no series
+	if detailedOnu.ID == 0 {
+		log.Debug().Int("board", boardID).Int("pon", ponID).Int("onu_id", onuID).Msg("ONU not found, skipping")
+		return onuSeries{}, true
+	}
+
+	// The labels come from the arguments, the same ONU as the key of the series in the snapshot
+	collectedAt := c.now()
+	b := newSeriesBuilder(onuMeasurement, collectedAt, "board", strconv.Itoa(boardID), "pon",
+		strconv.Itoa(ponID), "onu_id", strconv.Itoa(onuID))
 
-			if len(discoveredOnus) == 0 {
-				continue // No ONUs found, move to the next PON.
+	// ONU info metric with the configured labels, it has no numeric reading for the measurement
+	infoLabels := append(make([]string, 0, len(b.labels)+len(c.infoLabels)), b.labels...)
+	for _, label := range c.infoLabels {
+		infoLabels = append(infoLabels, infoLabelValues[label](detailedOnu))
+	}
+	b.metrics = appendMetric(b.metrics, c.infoDesc, prometheus.GaugeValue, 1, infoLabels...)
+
+	// Status and last offline reason as numeric codes, they keep a single series when they change
+	b.gauge(onuStatusDesc, "status", float64(utils.StatusCode(detailedOnu.Status)))
+	b.gauge(onuLastOfflineReasonDesc, "last_offline_reason", float64(utils.LastOfflineReasonCode(detailedOnu.LastOfflineReason)))
+
+	// Only report power metrics if the device is Online.
+	if detailedOnu.Status == "Online" {
+		// ONU Rx Power
+		if rxPower, err := strconv.ParseFloat(detailedOnu.RXPower, 64); err == nil {
+			// Filter out invalid readings
+			if rxPower < 100 {
+				b.gauge(onuRxPowerDesc, "rx_power_dbm", rxPower)
+				series.rxPower = &rxPower
 			}
+		} else {
+			log.Warn().Err(err).Msg("Could not parse RxPower")
+		}
 
-			// Fetch detailed information for each discovered ONU.
-			for _, discoveredOnu := range discoveredOnus {
-				onuID := discoveredOnu.ID
-				detailedOnu, err := c.onuUsecase.GetByBoardIDPonIDAndOnuID(boardID, ponID, onuID)
-				if err != nil {
-					log.Warn().Err(err).Int("board", boardID).Int("pon", ponID).Int("onu_id", onuID).Msg("Failed to get detailed ONU info")
-					continue // Move to the next ONU.
-				}
-
-				// --- Update Prometheus Metrics ---
-
-				labels := prometheus.Labels{
-					"board":  strconv.Itoa(detailedOnu.Board),
-					"pon":    strconv.Itoa(detailedOnu.PON),
-					"onu_id": strconv.Itoa(detailedOnu.ID),
-				}
-
-				// Set ONU Info Gauge
-				infoLabels := prometheus.Labels{
-					"board":          strconv.Itoa(detailedOnu.Board),
-					"pon":            strconv.Itoa(detailedOnu.PON),
-					"onu_id":         strconv.Itoa(detailedOnu.ID),
-					"name":           detailedOnu.Name,
-					"serial_number":  detailedOnu.SerialNumber,
-					"onu_type":       detailedOnu.OnuType,
-					"description":    detailedOnu.Description,
-					"ip_address":     detailedOnu.IPAddress,
-					"offline_reason": detailedOnu.LastOfflineReason,
-					"status":         detailedOnu.Status,
-				}
-				OnuInfoGauge.With(infoLabels).Set(1)
-
-				// Only report power metrics if the device is Online.
-				if detailedOnu.Status == "Online" {
-					// Set ONU Rx Power Gauge
-					if rxPower, err := strconv.ParseFloat(detailedOnu.RXPower, 64); err == nil {
-						// Filter out invalid readings
-						if rxPower < 100 {
-							OnuRxPowerGauge.With(labels).Set(rxPower)
-						}
-					} else {
-						log.Warn().Err(err).Msg("Could not parse RxPower")
-					}
-
-					// Set ONU Tx Power Gauge
-					if txPower, err := strconv.ParseFloat(detailedOnu.TXPower, 64); err == nil {
-						// Filter out invalid readings
-						if txPower < 100 {
-							OnuTxPowerGauge.With(labels).Set(txPower)
-						}
-					} else {
-						log.Warn().Err(err).Msg("Could not parse TxPower")
-					}
-				}
-
-				// Set other gauges
-				OnuUptimeGauge.With(labels).Set(parseDurationStringToSeconds(detailedOnu.Uptime))
-				OnuLastDownDurationGauge.With(labels).Set(parseDurationStringToSeconds(detailedOnu.LastDownTimeDuration))
-				OnuLastOnlineGauge.With(labels).Set(parseTimestampStringToEpoch(detailedOnu.LastOnline))
-				OnuLastOfflineGauge.With(labels).Set(parseTimestampStringToEpoch(detailedOnu.LastOffline))
-				if distance, err := strconv.ParseFloat(detailedOnu.GponOpticalDistance, 64); err == nil {
-					OnuGponOpticalDistanceGauge.With(labels).Set(distance)
-				} else {
-					log.Warn().Err(err).Msg("Could not parse GponOpticalDistance")
-				}
+		// ONU Tx Power
+		if txPower, err := strconv.ParseFloat(detailedOnu.TXPower, 64); err == nil {
+			// Filter out invalid readings
+			if txPower < 100 {
+				b.gauge(onuTxPowerDesc, "tx_power_dbm", txPower)
 			}
+		} else {
+			log.Warn().Err(err).Msg("Could not parse TxPower")
+		}
+
+		// OLT-measured upstream Rx Power
+		if oltRxPower, err := strconv.ParseFloat(detailedOnu.OltRXPower, 64); err == nil {
+			b.gauge(onuOltRxPowerDesc, "olt_rx_power_dbm", oltRxPower)
+		} else {
+			log.Warn().Err(err).Msg("Could not parse OltRxPower")
+		}
+
+		// Transceiver diagnostics, voltage and bias current are reported in V and mA
+		if temperature, err := strconv.ParseFloat(detailedOnu.Temperature, 64); err == nil {
+			b.gauge(onuTemperatureDesc, "temperature_celsius", temperature)
+		}
+		if voltage, err := strconv.ParseFloat(detailedOnu.Voltage, 64); err == nil {
+			b.gauge(onuVoltageDesc, "voltage_volts", voltage)
+		}
+		if biasCurrent, err := strconv.ParseFloat(detailedOnu.BiasCurrent, 64); err == nil {
+			b.gauge(onuBiasCurrentDesc, "bias_current_amperes", biasCurrent/1000)
+		}
+	}
+
+	// Other metrics
+	oltLocation := c.onuUsecase.GetOltLocation()
+	b.gauge(onuUptimeDesc, "uptime_seconds", parseDurationStringToSeconds(detailedOnu.Uptime))
+	b.gauge(onuLastDownDurationDesc, "last_down_duration_seconds", parseDurationStringToSeconds(detailedOnu.LastDownTimeDuration))
+	b.gauge(onuLastOnlineDesc, "last_online_timestamp_seconds", parseTimestampStringToEpoch(detailedOnu.LastOnline, oltLocation))
+	b.gauge(onuLastOfflineDesc, "last_offline_timestamp_seconds", parseTimestampStringToEpoch(detailedOnu.LastOffline, oltLocation))
+	if distance, err := strconv.ParseFloat(detailedOnu.GponOpticalDistance, 64); err == nil {
+		b.gauge(onuGponOpticalDistanceDesc, "gpon_optical_distance_meters", distance)
+		series.opticalDistance = &distance
+	} else {
+		log.Warn().Err(err).Msg("Could not parse GponOpticalDistance")
+	}
+
+	ok = true
+
+	// Traffic counters and UNI ports can only be read from an ONU that is Online.
+	if detailedOnu.Status == "Online" {
+		addTrafficMetrics(b, detailedOnu.Traffic)
+		series.uni, series.uniMeasurements, ok = c.collectUniPorts(ctx, boardID, ponID, onuID, collectedAt)
+		if !ok {
+			series.uni, series.uniMeasurements = previous.uni, previous.uniMeasurements
 		}
 	}
-}
\ No newline at end of file
+
+	series.onu = b.metrics
+	series.measurement = b.measurement()
+	return series, ok
+}
+
+// collectUniPorts builds the per-port UNI metrics and measurements of a single ONU.
+func (c *OnuCollector) collectUniPorts(ctx context.Context, boardID, ponID, onuID int, collectedAt time.Time) (
+	[]prometheus.Metric, []model.Measurement, bool,
+) {
+	uniPorts, err := c.onuUsecase.GetOnuUniPorts(ctx, boardID, ponID, onuID)
+	if err != nil {
+		log.Warn().Err(err).Int("board", boardID).Int("pon", ponID).Int("onu_id", onuID).Msg("Failed to get ONU UNI ports")
+		return nil, nil, false
+	}
+
+	metrics := make([]prometheus.Metric, 0, len(uniPorts)*3)
+	measurements := make([]model.Measurement, 0, len(uniPorts))
+	for _, uniPort := range uniPorts {
+		b := newSeriesBuilder(onuUniMeasurement, collectedAt, "board", strconv.Itoa(uniPort.Board), "pon",
+			strconv.Itoa(uniPort.PON), "onu_id", strconv.Itoa(uniPort.ID), "port", strconv.Itoa(uniPort.Port))
+		b.metrics = metrics
+
+		b.gauge(onuUniLinkUpDesc, "link_up", boolToFloat(uniPort.LinkState == "Up"))
+		b.gauge(onuUniAdminUpDesc, "admin_up", boolToFloat(uniPort.AdminState == "Unlocked"))
+		b.gauge(onuUniSpeedDesc, "speed_mbps", parseUniSpeedToMbps(uniPort.Speed))
+
+		metrics = b.metrics
+		measurements = append(measurements, b.measurement())
+	}
+	return metrics, measurements, true
+}
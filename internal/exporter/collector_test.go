@@ -0,0 +1,209 @@
+package exporter
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/usecase"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeOnuUsecase serves two Online ONUs on board 1 PON 1, any other method panics on the nil interface
+type fakeOnuUsecase struct {
+	usecase.OnuUseCaseInterface
+	discoverErr error
+	detailErr   error
+	vanished    map[int]bool // ONU IDs removed from the OLT since the list was cached
+	onDetail    func()
+}
+
+func (f *fakeOnuUsecase) GetByBoardIDAndPonID(_ context.Context, boardID, ponID int) ([]model.ONUInfoPerBoard, error) {
+	if f.discoverErr != nil {
+		return nil, f.discoverErr
+	}
+	return []model.ONUInfoPerBoard{{Board: boardID, PON: ponID, ID: 1}, {Board: boardID, PON: ponID, ID: 2}}, nil
+}
+
+func (f *fakeOnuUsecase) GetByBoardIDPonIDAndOnuID(_ context.Context, boardID, ponID, onuID int) (model.ONUCustomerInfo, error) {
+	if f.onDetail != nil {
+		f.onDetail()
+	}
+	if f.detailErr != nil && onuID == 2 {
+		return model.ONUCustomerInfo{}, f.detailErr
+	}
+	if f.vanished[onuID] {
+		return model.ONUCustomerInfo{}, nil
+	}
+	return model.ONUCustomerInfo{
+		Board: boardID, PON: ponID, ID: onuID, Name: "Isroh", SerialNumber: "ZTEGCEEA1119", Status: "Online",
+		RXPower: "-20.71", TXPower: "2.57", OltRXPower: "-23.9", GponOpticalDistance: "6701",
+		Traffic: model.ONUTraffic{UpstreamBytes: 1000, DownstreamBytes: 2000},
+	}, nil
+}
+
+func (f *fakeOnuUsecase) GetOnuUniPorts(_ context.Context, boardID, ponID, onuID int) ([]model.ONUUniPort, error) {
+	return []model.ONUUniPort{
+		{Board: boardID, PON: ponID, ID: onuID, Port: 1, LinkState: "Up", AdminState: "Unlocked", Speed: "1G"},
+	}, nil
+}
+
+func (f *fakeOnuUsecase) GetOltLocation() *time.Location {
+	return time.UTC
+}
+
+// testExporterConfig collects board 1 PON 1 with two workers
+var testExporterConfig = config.ExporterConfig{
+	Ranges:  []config.ExporterRangeConfig{{BoardMin: 1, BoardMax: 1, PonMin: 1, PonMax: 1}},
+	Workers: 2,
+}
+
+func newTestCollector(fake *fakeOnuUsecase) *OnuCollector {
+	c := NewOnuCollector(fake, testExporterConfig)
+	c.now = func() time.Time { return time.Date(2025, 8, 11, 10, 0, 0, 0, time.UTC) }
+	return c
+}
+
+func TestCollectorReportsNothingBeforeFirstSnapshot(t *testing.T) {
+	c := newTestCollector(&fakeOnuUsecase{})
+
+	// Only the scheduler counter is reported before the first snapshot
+	assert.Equal(t, 1, testutil.CollectAndCount(c))
+	assert.Equal(t, 1, testutil.CollectAndCount(c, "zte_onu_exporter_skipped_cycles_total"))
+}
+
+func TestCollectorSnapshot(t *testing.T) {
+	c := newTestCollector(&fakeOnuUsecase{})
+	c.collect(context.Background())
+
+	expected := `
+# HELP zte_onu_rx_power_dbm The received optical power of the ONU in dBm.
+# TYPE zte_onu_rx_power_dbm gauge
+zte_onu_rx_power_dbm{board="1",onu_id="1",pon="1"} -20.71
+zte_onu_rx_power_dbm{board="1",onu_id="2",pon="1"} -20.71
+# HELP zte_onu_uni_speed_mbps The negotiated speed of the ONU Ethernet UNI port in Mbit/s.
+# TYPE zte_onu_uni_speed_mbps gauge
+zte_onu_uni_speed_mbps{board="1",onu_id="1",pon="1",port="1"} 1000
+zte_onu_uni_speed_mbps{board="1",onu_id="2",pon="1",port="1"} 1000
+# HELP zte_onu_upstream_bytes_total The number of bytes received by the OLT from the ONU.
+# TYPE zte_onu_upstream_bytes_total counter
+zte_onu_upstream_bytes_total{board="1",onu_id="1",pon="1"} 1000
+zte_onu_upstream_bytes_total{board="1",onu_id="2",pon="1"} 1000
+# HELP zte_pon_rx_power_min_dbm The lowest received optical power of the Online ONUs on the PON in dBm.
+# TYPE zte_pon_rx_power_min_dbm gauge
+zte_pon_rx_power_min_dbm{board="1",pon="1"} -20.71
+# HELP zte_onu_exporter_last_collection_success Whether the last ONU collection read every board, PON and ONU without errors (1 = success, 0 = failure).
+# TYPE zte_onu_exporter_last_collection_success gauge
+zte_onu_exporter_last_collection_success 1
+`
+	assert.NoError(t, testutil.CollectAndCompare(c, strings.NewReader(expected),
+		"zte_onu_rx_power_dbm", "zte_onu_uni_speed_mbps", "zte_onu_upstream_bytes_total",
+		"zte_pon_rx_power_min_dbm", "zte_onu_exporter_last_collection_success"))
+
+	// The age grows with the clock until the next snapshot is stored
+	c.now = func() time.Time { return time.Date(2025, 8, 11, 10, 0, 42, 0, time.UTC) }
+	expected = `
+# HELP zte_onu_exporter_snapshot_age_seconds The number of seconds since the ONU metrics snapshot was completed.
+# TYPE zte_onu_exporter_snapshot_age_seconds gauge
+zte_onu_exporter_snapshot_age_seconds 42
+`
+	assert.NoError(t, testutil.CollectAndCompare(c, strings.NewReader(expected), "zte_onu_exporter_snapshot_age_seconds"))
+}
+
+func TestCollectorServesPreviousSnapshotDuringCollection(t *testing.T) {
+	fake := &fakeOnuUsecase{}
+	c := newTestCollector(fake)
+	c.collect(context.Background())
+	complete := testutil.CollectAndCount(c)
+	require.Greater(t, complete, 3)
+
+	// Every scrape during the next run sees the whole previous snapshot
+	var mu sync.Mutex
+	var counts []int
+	fake.onDetail = func() {
+		count := testutil.CollectAndCount(c)
+		mu.Lock()
+		counts = append(counts, count)
+		mu.Unlock()
+	}
+	c.collect(context.Background())
+
+	assert.Equal(t, []int{complete, complete}, counts)
+	assert.Equal(t, complete, testutil.CollectAndCount(c))
+}
+
+func TestCollectorKeepsPreviousMetricsOnFailure(t *testing.T) {
+	fake := &fakeOnuUsecase{}
+	c := newTestCollector(fake)
+	c.collect(context.Background())
+	complete := testutil.CollectAndCount(c)
+
+	// A failed ONU keeps its series and the run is reported as failed
+	fake.detailErr = errors.New("request timeout")
+	c.collect(context.Background())
+	assert.Equal(t, complete, testutil.CollectAndCount(c))
+	assert.Equal(t, 2, testutil.CollectAndCount(c, "zte_onu_rx_power_dbm"))
+	assertCollectionSuccess(t, c, "0")
+
+	// A PON that cannot be discovered keeps all of its ONUs
+	fake.detailErr = nil
+	fake.discoverErr = errors.New("request timeout")
+	c.collect(context.Background())
+	assert.Equal(t, complete, testutil.CollectAndCount(c))
+
+	// Once the OLT answers again the run succeeds
+	fake.discoverErr = nil
+	c.collect(context.Background())
+	assertCollectionSuccess(t, c, "1")
+}
+
+func TestCollectorSkipsVanishedOnus(t *testing.T) {
+	c := newTestCollector(&fakeOnuUsecase{vanished: map[int]bool{1: true, 2: true}})
+	c.collect(context.Background())
+
+	// Two removed ONUs must not give duplicate series that fail the whole scrape
+	registry := prometheus.NewPedanticRegistry()
+	require.NoError(t, registry.Register(c))
+	families, err := registry.Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				assert.NotEqual(t, "0", label.GetValue(), "%s has label %s=0", family.GetName(), label.GetName())
+			}
+		}
+	}
+	assert.Equal(t, 0, testutil.CollectAndCount(c, "zte_onu_info", "zte_onu_rx_power_dbm"))
+	assertCollectionSuccess(t, c, "1")
+}
+
+func TestCollectorCanceledRunKeepsSnapshot(t *testing.T) {
+	fake := &fakeOnuUsecase{}
+	c := newTestCollector(fake)
+	c.collect(context.Background())
+	first := c.snapshot.Load()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	fake.onDetail = cancel
+	c.collect(ctx)
+	assert.Same(t, first, c.snapshot.Load())
+}
+
+// assertCollectionSuccess checks the value of zte_onu_exporter_last_collection_success.
+func assertCollectionSuccess(t *testing.T, c *OnuCollector, value string) {
+	t.Helper()
+	expected := `
+# HELP zte_onu_exporter_last_collection_success Whether the last ONU collection read every board, PON and ONU without errors (1 = success, 0 = failure).
+# TYPE zte_onu_exporter_last_collection_success gauge
+zte_onu_exporter_last_collection_success ` + value + "\n"
+	assert.NoError(t, testutil.CollectAndCompare(c, strings.NewReader(expected), "zte_onu_exporter_last_collection_success"))
+}
@@ -2,79 +2,146 @@ package exporter
 
 import (
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
 var (
-	// OnuInfoGauge provides basic information about the ONU.
-	OnuInfoGauge = promauto.NewGaugeVec(
-		prometheus.GaugeOpts{
-			Name: "zte_onu_info",
-			Help: "Information about the ZTE ONU device.",
-		},
-		[]string{"board", "pon", "onu_id", "name", "serial_number", "onu_type", "description", "ip_address", "offline_reason", "status"},
-	)
-
-	// OnuRxPowerGauge shows the received optical power of the ONU.
-	OnuRxPowerGauge = promauto.NewGaugeVec(
-		prometheus.GaugeOpts{
-			Name: "zte_onu_rx_power_dbm",
-			Help: "The received optical power of the ONU in dBm.",
-		},
-		[]string{"board", "pon", "onu_id"},
-	)
-
-	// OnuTxPowerGauge shows the transmitted optical power of the ONU.
-	OnuTxPowerGauge = promauto.NewGaugeVec(
-		prometheus.GaugeOpts{
-			Name: "zte_onu_tx_power_dbm",
-			Help: "The transmitted optical power of the ONU in dBm.",
-		},
-		[]string{"board", "pon", "onu_id"},
-	)
-
-	// OnuUptimeGauge shows the uptime of the ONU in seconds.
-	OnuUptimeGauge = promauto.NewGaugeVec(
-		prometheus.GaugeOpts{
-			Name: "zte_onu_uptime_seconds",
-			Help: "The uptime of the ONU in seconds.",
-		},
-		[]string{"board", "pon", "onu_id"},
-	)
-
-	// OnuLastDownDurationGauge shows the duration of the last downtime in seconds.
-	OnuLastDownDurationGauge = promauto.NewGaugeVec(
-		prometheus.GaugeOpts{
-			Name: "zte_onu_last_down_duration_seconds",
-			Help: "The duration of the last downtime in seconds.",
-		},
-		[]string{"board", "pon", "onu_id"},
-	)
-
-	// OnuLastOnlineGauge shows the last online timestamp as a Unix epoch.
-	OnuLastOnlineGauge = promauto.NewGaugeVec(
-		prometheus.GaugeOpts{
-			Name: "zte_onu_last_online_timestamp_seconds",
-			Help: "The last online timestamp of the ONU as a Unix epoch.",
-		},
-		[]string{"board", "pon", "onu_id"},
-	)
-
-	// OnuLastOfflineGauge shows the last offline timestamp as a Unix epoch.
-	OnuLastOfflineGauge = promauto.NewGaugeVec(
-		prometheus.GaugeOpts{
-			Name: "zte_onu_last_offline_timestamp_seconds",
-			Help: "The last offline timestamp of the ONU as a Unix epoch.",
-		},
-		[]string{"board", "pon", "onu_id"},
-	)
-
-	// OnuGponOpticalDistanceGauge shows the GPON optical distance in meters.
-	OnuGponOpticalDistanceGauge = promauto.NewGaugeVec(
-		prometheus.GaugeOpts{
-			Name: "zte_onu_gpon_optical_distance_meters",
-			Help: "The GPON optical distance to the ONU in meters.",
-		},
-		[]string{"board", "pon", "onu_id"},
-	)
-)
\ No newline at end of file
+	// onuStatusDesc shows the ONU state as the numeric code reported by the OLT.
+	onuStatusDesc = prometheus.NewDesc(
+		"zte_onu_status",
+		"The ONU state code reported by the OLT (1 = Logging, 2 = LOS, 3 = Synchronization, 4 = Online, 5 = Dying Gasp, 6 = Auth Failed, 7 = Offline, 0 = Unknown).",
+		[]string{"board", "pon", "onu_id"}, nil,
+	)
+
+	// onuLastOfflineReasonDesc shows the reason the ONU last went offline as the numeric code reported by the OLT.
+	onuLastOfflineReasonDesc = prometheus.NewDesc(
+		"zte_onu_last_offline_reason",
+		"The reason code of the last time the ONU went offline (1 = Unknown, 2 = LOS, 3 = LOSi, 4 = LOFi, 5 = sfi, 6 = loai, 7 = loami, 8 = AuthFail, 9 = PowerOff, 10 = deactiveSucc, 11 = deactiveFail, 12 = Reboot, 13 = Shutdown).",
+		[]string{"board", "pon", "onu_id"}, nil,
+	)
+
+	// onuRxPowerDesc shows the received optical power of the ONU.
+	onuRxPowerDesc = prometheus.NewDesc(
+		"zte_onu_rx_power_dbm",
+		"The received optical power of the ONU in dBm.",
+		[]string{"board", "pon", "onu_id"}, nil,
+	)
+
+	// onuTxPowerDesc shows the transmitted optical power of the ONU.
+	onuTxPowerDesc = prometheus.NewDesc(
+		"zte_onu_tx_power_dbm",
+		"The transmitted optical power of the ONU in dBm.",
+		[]string{"board", "pon", "onu_id"}, nil,
+	)
+
+	// onuOltRxPowerDesc shows the upstream optical power of the ONU as measured by the OLT.
+	onuOltRxPowerDesc = prometheus.NewDesc(
+		"zte_onu_olt_rx_power_dbm",
+		"The upstream optical power of the ONU measured by the OLT in dBm.",
+		[]string{"board", "pon", "onu_id"}, nil,
+	)
+
+	// onuTemperatureDesc shows the transceiver temperature reported by the ONU.
+	onuTemperatureDesc = prometheus.NewDesc(
+		"zte_onu_temperature_celsius",
+		"The transceiver temperature of the ONU in degrees Celsius.",
+		[]string{"board", "pon", "onu_id"}, nil,
+	)
+
+	// onuVoltageDesc shows the transceiver supply voltage reported by the ONU.
+	onuVoltageDesc = prometheus.NewDesc(
+		"zte_onu_voltage_volts",
+		"The transceiver supply voltage of the ONU in volts.",
+		[]string{"board", "pon", "onu_id"}, nil,
+	)
+
+	// onuBiasCurrentDesc shows the laser bias current reported by the ONU.
+	onuBiasCurrentDesc = prometheus.NewDesc(
+		"zte_onu_bias_current_amperes",
+		"The transceiver laser bias current of the ONU in amperes.",
+		[]string{"board", "pon", "onu_id"}, nil,
+	)
+
+	// onuUptimeDesc shows the uptime of the ONU in seconds.
+	onuUptimeDesc = prometheus.NewDesc(
+		"zte_onu_uptime_seconds",
+		"The uptime of the ONU in seconds.",
+		[]string{"board", "pon", "onu_id"}, nil,
+	)
+
+	// onuLastDownDurationDesc shows the duration of the last downtime in seconds.
+	onuLastDownDurationDesc = prometheus.NewDesc(
+		"zte_onu_last_down_duration_seconds",
+		"The duration of the last downtime in seconds.",
+		[]string{"board", "pon", "onu_id"}, nil,
+	)
+
+	// onuLastOnlineDesc shows the last online timestamp as a Unix epoch.
+	onuLastOnlineDesc = prometheus.NewDesc(
+		"zte_onu_last_online_timestamp_seconds",
+		"The last online timestamp of the ONU as a Unix epoch.",
+		[]string{"board", "pon", "onu_id"}, nil,
+	)
+
+	// onuLastOfflineDesc shows the last offline timestamp as a Unix epoch.
+	onuLastOfflineDesc = prometheus.NewDesc(
+		"zte_onu_last_offline_timestamp_seconds",
+		"The last offline timestamp of the ONU as a Unix epoch.",
+		[]string{"board", "pon", "onu_id"}, nil,
+	)
+
+	// onuGponOpticalDistanceDesc shows the GPON optical distance in meters.
+	onuGponOpticalDistanceDesc = prometheus.NewDesc(
+		"zte_onu_gpon_optical_distance_meters",
+		"The GPON optical distance to the ONU in meters.",
+		[]string{"board", "pon", "onu_id"}, nil,
+	)
+
+	// onuUniLinkUpDesc shows whether an ONU Ethernet UNI port has link.
+	onuUniLinkUpDesc = prometheus.NewDesc(
+		"zte_onu_uni_link_up",
+		"Whether the ONU Ethernet UNI port has link (1 = up, 0 = down).",
+		[]string{"board", "pon", "onu_id", "port"}, nil,
+	)
+
+	// onuUniAdminUpDesc shows whether an ONU Ethernet UNI port is administratively enabled.
+	onuUniAdminUpDesc = prometheus.NewDesc(
+		"zte_onu_uni_admin_up",
+		"Whether the ONU Ethernet UNI port is administratively unlocked (1 = unlocked, 0 = locked).",
+		[]string{"board", "pon", "onu_id", "port"}, nil,
+	)
+
+	// onuUniSpeedDesc shows the negotiated speed of an ONU Ethernet UNI port.
+	onuUniSpeedDesc = prometheus.NewDesc(
+		"zte_onu_uni_speed_mbps",
+		"The negotiated speed of the ONU Ethernet UNI port in Mbit/s.",
+		[]string{"board", "pon", "onu_id", "port"}, nil,
+	)
+
+	// snapshotAgeDesc shows how long ago the snapshot served on /metrics was completed.
+	snapshotAgeDesc = prometheus.NewDesc(
+		"zte_onu_exporter_snapshot_age_seconds",
+		"The number of seconds since the ONU metrics snapshot was completed.",
+		nil, nil,
+	)
+
+	// lastCollectionSuccessDesc shows whether every board, PON and ONU was read in the last collection.
+	lastCollectionSuccessDesc = prometheus.NewDesc(
+		"zte_onu_exporter_last_collection_success",
+		"Whether the last ONU collection read every board, PON and ONU without errors (1 = success, 0 = failure).",
+		nil, nil,
+	)
+
+	// skippedCyclesDesc counts the collection cycles dropped because the previous one was still running.
+	skippedCyclesDesc = prometheus.NewDesc(
+		"zte_onu_exporter_skipped_cycles_total",
+		"The number of ONU collection cycles skipped because the previous cycle was still running.",
+		nil, nil,
+	)
+
+	// lastCollectionDurationDesc shows how long the last collection took.
+	lastCollectionDurationDesc = prometheus.NewDesc(
+		"zte_onu_exporter_last_collection_duration_seconds",
+		"The duration of the last ONU collection in seconds.",
+		nil, nil,
+	)
+)
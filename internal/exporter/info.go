@@ -0,0 +1,58 @@
+package exporter
+
+import (
+	"strings"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog/log"
+)
+
+// infoLabelValues maps the optional labels of zte_onu_info to the ONU field they report.
+var infoLabelValues = map[string]func(onu model.ONUCustomerInfo) string{
+	"name":           func(onu model.ONUCustomerInfo) string { return onu.Name },
+	"serial_number":  func(onu model.ONUCustomerInfo) string { return onu.SerialNumber },
+	"onu_type":       func(onu model.ONUCustomerInfo) string { return onu.OnuType },
+	"description":    func(onu model.ONUCustomerInfo) string { return onu.Description },
+	"ip_address":     func(onu model.ONUCustomerInfo) string { return onu.IPAddress },
+	"offline_reason": func(onu model.ONUCustomerInfo) string { return onu.LastOfflineReason },
+	"status":         func(onu model.ONUCustomerInfo) string { return onu.Status },
+}
+
+// DefaultInfoLabels are the optional labels of zte_onu_info when none are configured, the labels it always had so
+// existing dashboards and alerts keep working. The status and offline reason start a new series on every change,
+// configure the labels without them to rely on zte_onu_status and zte_onu_last_offline_reason instead.
+var DefaultInfoLabels = []string{"name", "serial_number", "onu_type", "description", "ip_address", "offline_reason", "status"}
+
+// normalizeInfoLabels checks the configured zte_onu_info labels. No labels gives DefaultInfoLabels and "none"
+// keeps only board, pon and onu_id. Unknown and repeated labels are logged and skipped.
+func normalizeInfoLabels(configured []string) []string {
+	if len(configured) == 0 {
+		return DefaultInfoLabels
+	}
+	if len(configured) == 1 && strings.TrimSpace(configured[0]) == "none" {
+		return []string{}
+	}
+
+	labels := make([]string, 0, len(infoLabelValues))
+	seen := make(map[string]bool, len(infoLabelValues))
+	for _, label := range configured {
+		label = strings.TrimSpace(label)
+		if _, ok := infoLabelValues[label]; !ok || seen[label] {
+			log.Warn().Str("label", label).Msg("Skipping unknown or repeated zte_onu_info label")
+			continue
+		}
+		seen[label] = true
+		labels = append(labels, label)
+	}
+	return labels
+}
+
+// newOnuInfoDesc creates the zte_onu_info descriptor with board, pon, onu_id and the given optional labels.
+func newOnuInfoDesc(infoLabels []string) *prometheus.Desc {
+	return prometheus.NewDesc(
+		"zte_onu_info",
+		"Information about the ZTE ONU device.",
+		append([]string{"board", "pon", "onu_id"}, infoLabels...), nil,
+	)
+}
@@ -0,0 +1,38 @@
+package exporter
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeInfoLabels(t *testing.T) {
+	assert.Equal(t, DefaultInfoLabels, normalizeInfoLabels(nil))
+	assert.Equal(t, []string{}, normalizeInfoLabels([]string{"none"}))
+	assert.Equal(t, []string{"serial_number", "status"}, normalizeInfoLabels([]string{" serial_number", "status "}))
+
+	// Unknown and repeated labels are skipped
+	assert.Equal(t, []string{"name"}, normalizeInfoLabels([]string{"name", "board", "name", "vlan"}))
+}
+
+func TestCollectorInfoLabelsAndStatusCodes(t *testing.T) {
+	cfg := testExporterConfig
+	cfg.InfoLabels = []string{"serial_number"}
+	c := NewOnuCollector(&fakeOnuUsecase{}, cfg)
+	c.collect(context.Background())
+
+	expected := `
+# HELP zte_onu_info Information about the ZTE ONU device.
+# TYPE zte_onu_info gauge
+zte_onu_info{board="1",onu_id="1",pon="1",serial_number="ZTEGCEEA1119"} 1
+zte_onu_info{board="1",onu_id="2",pon="1",serial_number="ZTEGCEEA1119"} 1
+# HELP zte_onu_status The ONU state code reported by the OLT (1 = Logging, 2 = LOS, 3 = Synchronization, 4 = Online, 5 = Dying Gasp, 6 = Auth Failed, 7 = Offline, 0 = Unknown).
+# TYPE zte_onu_status gauge
+zte_onu_status{board="1",onu_id="1",pon="1"} 4
+zte_onu_status{board="1",onu_id="2",pon="1"} 4
+`
+	assert.NoError(t, testutil.CollectAndCompare(c, strings.NewReader(expected), "zte_onu_info", "zte_onu_status"))
+}
@@ -0,0 +1,74 @@
+package exporter
+
+import (
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Measurement names of the points handed to the sinks.
+const (
+	onuMeasurement    = "zte_onu"
+	onuUniMeasurement = "zte_onu_uni"
+	ponMeasurement    = "zte_pon"
+)
+
+// seriesBuilder builds the constant metrics and the measurement of a single ONU, UNI port or PON together,
+// so the Prometheus series and the fields written to the sinks always carry the same readings.
+type seriesBuilder struct {
+	name    string
+	time    time.Time
+	tags    map[string]string
+	labels  []string
+	metrics []prometheus.Metric
+	fields  map[string]float64
+}
+
+// newSeriesBuilder creates a seriesBuilder for the measurement name. keyValues are pairs of tag names and
+// values, the values are also the labels of every metric in the same order.
+func newSeriesBuilder(name string, collectedAt time.Time, keyValues ...string) *seriesBuilder {
+	b := &seriesBuilder{
+		name:   name,
+		time:   collectedAt,
+		tags:   make(map[string]string, len(keyValues)/2),
+		labels: make([]string, 0, len(keyValues)/2),
+		fields: make(map[string]float64),
+	}
+	for i := 0; i+1 < len(keyValues); i += 2 {
+		b.tags[keyValues[i]] = keyValues[i+1]
+		b.labels = append(b.labels, keyValues[i+1])
+	}
+	return b
+}
+
+// gauge appends a gauge with the builder labels and records the same value as field.
+func (b *seriesBuilder) gauge(desc *prometheus.Desc, field string, value float64) {
+	b.metrics = appendMetric(b.metrics, desc, prometheus.GaugeValue, value, b.labels...)
+	b.fields[field] = value
+}
+
+// counter appends a counter with the builder labels and records the same value as field.
+func (b *seriesBuilder) counter(desc *prometheus.Desc, field string, value float64) {
+	b.metrics = appendMetric(b.metrics, desc, prometheus.CounterValue, value, b.labels...)
+	b.fields[field] = value
+}
+
+// measurement returns the fields recorded so far as a measurement.
+func (b *seriesBuilder) measurement() model.Measurement {
+	return model.Measurement{Name: b.name, Tags: b.tags, Fields: b.fields, Time: b.time}
+}
+
+// measurements returns all points of a snapshot, the ONUs and PONs that failed in the last run keep the
+// points and timestamps of the run that last read them.
+func (s *snapshot) measurements() []model.Measurement {
+	points := make([]model.Measurement, 0, len(s.onus)*2+len(s.pons))
+	for _, series := range s.onus {
+		points = append(points, series.measurement)
+		points = append(points, series.uniMeasurements...)
+	}
+	for _, series := range s.pons {
+		points = append(points, series.measurement)
+	}
+	return points
+}
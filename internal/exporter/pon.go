@@ -0,0 +1,154 @@
+package exporter
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// onuSlotsPerPon is the number of ONU IDs a GPON port of the C320 can register, GetEmptyOnuID checks the same range.
+const onuSlotsPerPon = 128
+
+// onuStatuses are the ONU states reported by the OLT, every PON reports all of them so a count that drops to
+// zero does not leave a gap.
+var onuStatuses = []string{"Logging", "LOS", "Synchronization", "Online", "Dying Gasp", "Auth Failed", "Offline", "Unknown"}
+
+// otherOnuStatus counts the ONUs whose status is not one of onuStatuses, such as an empty status, so the status
+// counts add up to the registered ONUs.
+const otherOnuStatus = "Other"
+
+var (
+	ponOnusDesc = prometheus.NewDesc(
+		"zte_pon_onus",
+		"The number of registered ONUs on the PON by status.",
+		[]string{"board", "pon", "status"}, nil,
+	)
+	ponRegisteredOnusDesc = prometheus.NewDesc(
+		"zte_pon_registered_onus",
+		"The number of ONU IDs registered on the PON.",
+		[]string{"board", "pon"}, nil,
+	)
+	ponOnuCapacityDesc = prometheus.NewDesc(
+		"zte_pon_onu_capacity",
+		"The number of ONU IDs the PON can register.",
+		[]string{"board", "pon"}, nil,
+	)
+	ponFreeOnuSlotsDesc = prometheus.NewDesc(
+		"zte_pon_free_onu_slots",
+		"The number of ONU IDs still free on the PON.",
+		[]string{"board", "pon"}, nil,
+	)
+	ponRxPowerMinDesc = prometheus.NewDesc(
+		"zte_pon_rx_power_min_dbm",
+		"The lowest received optical power of the Online ONUs on the PON in dBm.",
+		[]string{"board", "pon"}, nil,
+	)
+	ponRxPowerAvgDesc = prometheus.NewDesc(
+		"zte_pon_rx_power_avg_dbm",
+		"The average received optical power of the Online ONUs on the PON in dBm.",
+		[]string{"board", "pon"}, nil,
+	)
+	ponRxPowerP10Desc = prometheus.NewDesc(
+		"zte_pon_rx_power_p10_dbm",
+		"The 10th percentile of the received optical power of the Online ONUs on the PON in dBm.",
+		[]string{"board", "pon"}, nil,
+	)
+	ponRxPowerBelowThresholdDesc = prometheus.NewDesc(
+		"zte_pon_rx_power_below_threshold_onus",
+		"The number of Online ONUs on the PON with a received optical power below the threshold.",
+		[]string{"board", "pon"}, nil,
+	)
+	ponGponOpticalDistanceAvgDesc = prometheus.NewDesc(
+		"zte_pon_gpon_optical_distance_avg_meters",
+		"The average GPON optical distance to the ONUs on the PON in meters.",
+		[]string{"board", "pon"}, nil,
+	)
+)
+
+// ponMetrics builds the aggregate metrics and measurement of a PON from its discovered ONUs and their series
+// in the snapshot. The rx power aggregates come from the series, the same readings as zte_onu_rx_power_dbm, and
+// are left out when no Online ONU has a valid reading, the distance average when no ONU reported a distance.
+func ponMetrics(boardID, ponID int, discoveredOnus []model.ONUInfoPerBoard, onus map[model.OnuID]onuSeries,
+	rxPowerThreshold float64, collectedAt time.Time,
+) ponSeries {
+	b := newSeriesBuilder(ponMeasurement, collectedAt, "board", strconv.Itoa(boardID), "pon", strconv.Itoa(ponID))
+	b.metrics = make([]prometheus.Metric, 0, len(onuStatuses)+9)
+
+	statusCounts := make(map[string]int, len(onuStatuses)+1)
+	registered := make(map[int]bool, len(discoveredOnus))
+	rxPowers := make([]float64, 0, len(discoveredOnus))
+	belowThreshold := 0
+	distanceSum, distanceCount := 0.0, 0
+
+	for _, onu := range discoveredOnus {
+		if slices.Contains(onuStatuses, onu.Status) {
+			statusCounts[onu.Status]++
+		} else {
+			statusCounts[otherOnuStatus]++
+		}
+
+		// Count the registered IDs the same way GetEmptyOnuID finds the free ones
+		if onu.ID >= 1 && onu.ID <= onuSlotsPerPon {
+			registered[onu.ID] = true
+		}
+
+		series, ok := onus[model.OnuID{Board: boardID, PON: ponID, ID: onu.ID}]
+		if !ok {
+			continue
+		}
+		if series.rxPower != nil {
+			rxPowers = append(rxPowers, *series.rxPower)
+			if *series.rxPower < rxPowerThreshold {
+				belowThreshold++
+			}
+		}
+		if series.opticalDistance != nil {
+			distanceSum += *series.opticalDistance
+			distanceCount++
+		}
+	}
+
+	// The status is a label of the metric, the measurement has a field per status such as onus_dying_gasp
+	for _, status := range slices.Concat(onuStatuses, []string{otherOnuStatus}) {
+		count := float64(statusCounts[status])
+		b.metrics = appendMetric(b.metrics, ponOnusDesc, prometheus.GaugeValue, count, b.labels[0], b.labels[1], status)
+		b.fields["onus_"+strings.ReplaceAll(strings.ToLower(status), " ", "_")] = count
+	}
+
+	b.gauge(ponRegisteredOnusDesc, "registered_onus", float64(len(registered)))
+	b.gauge(ponOnuCapacityDesc, "onu_capacity", onuSlotsPerPon)
+	b.gauge(ponFreeOnuSlotsDesc, "free_onu_slots", float64(onuSlotsPerPon-len(registered)))
+	b.gauge(ponRxPowerBelowThresholdDesc, "rx_power_below_threshold_onus", float64(belowThreshold))
+
+	if len(rxPowers) > 0 {
+		sort.Float64s(rxPowers)
+		sum := 0.0
+		for _, rxPower := range rxPowers {
+			sum += rxPower
+		}
+		b.gauge(ponRxPowerMinDesc, "rx_power_min_dbm", rxPowers[0])
+		b.gauge(ponRxPowerAvgDesc, "rx_power_avg_dbm", sum/float64(len(rxPowers)))
+		b.gauge(ponRxPowerP10Desc, "rx_power_p10_dbm", percentile(rxPowers, 10))
+	}
+
+	if distanceCount > 0 {
+		b.gauge(ponGponOpticalDistanceAvgDesc, "gpon_optical_distance_avg_meters", distanceSum/float64(distanceCount))
+	}
+
+	return ponSeries{metrics: b.metrics, measurement: b.measurement()}
+}
+
+// percentile returns the p-th percentile of sorted values using the nearest-rank method.
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
@@ -0,0 +1,123 @@
+package exporter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// metricsCollector serves a fixed set of metrics so testutil can compare them
+type metricsCollector []prometheus.Metric
+
+func (m metricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(m, ch)
+}
+
+func (m metricsCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, metric := range m {
+		ch <- metric
+	}
+}
+
+func TestPonMetrics(t *testing.T) {
+	distance := 1500.0
+	farDistance := 6700.0
+	// The cached list is older than the detail reads, the rx power aggregates follow the series
+	discovered := []model.ONUInfoPerBoard{
+		{ID: 1, Status: "Online", RXPower: "-18.0"},
+		{ID: 2, Status: "Online", RXPower: "-21.0"},
+		{ID: 3, Status: "Online", RXPower: "-22.0"},
+		{ID: 4, Status: "Online", RXPower: "65535"}, // invalid reading
+		{ID: 5, Status: "LOS", RXPower: "-40.0"},
+		{ID: 6, Status: "Dying Gasp"},
+		{ID: 7, Status: ""},
+	}
+	rxPowers := []float64{-20.5, -28.25, -22.0}
+	onus := map[model.OnuID]onuSeries{
+		{Board: 2, PON: 7, ID: 1}: {opticalDistance: &distance, rxPower: &rxPowers[0]},
+		{Board: 2, PON: 7, ID: 2}: {opticalDistance: &farDistance, rxPower: &rxPowers[1]},
+		{Board: 2, PON: 7, ID: 3}: {rxPower: &rxPowers[2]},
+		{Board: 2, PON: 7, ID: 4}: {},
+	}
+
+	expected := `
+# HELP zte_pon_onus The number of registered ONUs on the PON by status.
+# TYPE zte_pon_onus gauge
+zte_pon_onus{board="2",pon="7",status="Auth Failed"} 0
+zte_pon_onus{board="2",pon="7",status="Dying Gasp"} 1
+zte_pon_onus{board="2",pon="7",status="LOS"} 1
+zte_pon_onus{board="2",pon="7",status="Logging"} 0
+zte_pon_onus{board="2",pon="7",status="Offline"} 0
+zte_pon_onus{board="2",pon="7",status="Online"} 4
+zte_pon_onus{board="2",pon="7",status="Other"} 1
+zte_pon_onus{board="2",pon="7",status="Synchronization"} 0
+zte_pon_onus{board="2",pon="7",status="Unknown"} 0
+# HELP zte_pon_registered_onus The number of ONU IDs registered on the PON.
+# TYPE zte_pon_registered_onus gauge
+zte_pon_registered_onus{board="2",pon="7"} 7
+# HELP zte_pon_onu_capacity The number of ONU IDs the PON can register.
+# TYPE zte_pon_onu_capacity gauge
+zte_pon_onu_capacity{board="2",pon="7"} 128
+# HELP zte_pon_free_onu_slots The number of ONU IDs still free on the PON.
+# TYPE zte_pon_free_onu_slots gauge
+zte_pon_free_onu_slots{board="2",pon="7"} 121
+# HELP zte_pon_rx_power_below_threshold_onus The number of Online ONUs on the PON with a received optical power below the threshold.
+# TYPE zte_pon_rx_power_below_threshold_onus gauge
+zte_pon_rx_power_below_threshold_onus{board="2",pon="7"} 1
+# HELP zte_pon_rx_power_min_dbm The lowest received optical power of the Online ONUs on the PON in dBm.
+# TYPE zte_pon_rx_power_min_dbm gauge
+zte_pon_rx_power_min_dbm{board="2",pon="7"} -28.25
+# HELP zte_pon_rx_power_avg_dbm The average received optical power of the Online ONUs on the PON in dBm.
+# TYPE zte_pon_rx_power_avg_dbm gauge
+zte_pon_rx_power_avg_dbm{board="2",pon="7"} -23.583333333333332
+# HELP zte_pon_rx_power_p10_dbm The 10th percentile of the received optical power of the Online ONUs on the PON in dBm.
+# TYPE zte_pon_rx_power_p10_dbm gauge
+zte_pon_rx_power_p10_dbm{board="2",pon="7"} -28.25
+# HELP zte_pon_gpon_optical_distance_avg_meters The average GPON optical distance to the ONUs on the PON in meters.
+# TYPE zte_pon_gpon_optical_distance_avg_meters gauge
+zte_pon_gpon_optical_distance_avg_meters{board="2",pon="7"} 4100
+`
+	collectedAt := time.Date(2025, 8, 11, 10, 0, 0, 0, time.UTC)
+	series := ponMetrics(2, 7, discovered, onus, -27, collectedAt)
+	assert.NoError(t, testutil.CollectAndCompare(metricsCollector(series.metrics), strings.NewReader(expected)))
+
+	// The measurement carries the same readings with a field per status
+	assert.Equal(t, ponMeasurement, series.measurement.Name)
+	assert.Equal(t, map[string]string{"board": "2", "pon": "7"}, series.measurement.Tags)
+	assert.Equal(t, collectedAt, series.measurement.Time)
+	assert.Equal(t, 4.0, series.measurement.Fields["onus_online"])
+	assert.Equal(t, 1.0, series.measurement.Fields["onus_dying_gasp"])
+	assert.Equal(t, 0.0, series.measurement.Fields["onus_auth_failed"])
+	assert.Equal(t, 1.0, series.measurement.Fields["onus_other"])
+	assert.Equal(t, 121.0, series.measurement.Fields["free_onu_slots"])
+	assert.Equal(t, -28.25, series.measurement.Fields["rx_power_min_dbm"])
+	assert.Equal(t, 4100.0, series.measurement.Fields["gpon_optical_distance_avg_meters"])
+}
+
+func TestPonMetricsEmptyPon(t *testing.T) {
+	series := ponMetrics(1, 1, nil, nil, -27, time.Now())
+	metrics := metricsCollector(series.metrics)
+
+	// An empty PON reports its capacity but no rx power or distance aggregates
+	assert.Equal(t, 0, testutil.CollectAndCount(metrics, "zte_pon_rx_power_min_dbm", "zte_pon_gpon_optical_distance_avg_meters"))
+	expected := `
+# HELP zte_pon_free_onu_slots The number of ONU IDs still free on the PON.
+# TYPE zte_pon_free_onu_slots gauge
+zte_pon_free_onu_slots{board="1",pon="1"} 128
+`
+	assert.NoError(t, testutil.CollectAndCompare(metrics, strings.NewReader(expected), "zte_pon_free_onu_slots"))
+	assert.NotContains(t, series.measurement.Fields, "rx_power_min_dbm")
+}
+
+func TestPercentile(t *testing.T) {
+	values := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}
+	assert.Equal(t, 2.0, percentile(values, 10))
+	assert.Equal(t, 10.0, percentile(values, 50))
+	assert.Equal(t, 20.0, percentile(values, 100))
+	assert.Equal(t, 7.0, percentile([]float64{7}, 10))
+}
@@ -0,0 +1,187 @@
+package exporter
+
+import (
+	"context"
+	"math/rand/v2"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/rs/zerolog/log"
+)
+
+// Overrun policies, what the scheduler does with a cycle that is due while the previous one still runs.
+const (
+	OverrunPolicySkip  = "skip"  // Drop the cycle that is due and wait for the next tick
+	OverrunPolicyQueue = "queue" // Start the cycle as soon as the running one finishes, at most one waits
+)
+
+// Defaults of the collection schedule, used for zero values of config.ExporterConfig.
+const (
+	defaultInterval = 30 * time.Second
+	defaultWorkers  = 4
+)
+
+// defaultRange is the range collected when none is configured, both boards with all their PONs. It is also the
+// limit of every configured range, the OLT has no other board or PON.
+var defaultRange = config.ExporterRangeConfig{BoardMin: 1, BoardMax: 2, PonMin: 1, PonMax: 16}
+
+// ApplyEnv overrides the exporter configuration with the PROMETHEUS_* environment variables that are set.
+// Any of PROMETHEUS_BOARD_MIN, PROMETHEUS_BOARD_MAX, PROMETHEUS_PON_MIN and PROMETHEUS_PON_MAX replaces the
+// configured ranges with a single range, the bounds that are not set keep the default.
+func ApplyEnv(cfg config.ExporterConfig) config.ExporterConfig {
+	envRange := defaultRange
+	rangeSet := false
+	for name, bound := range map[string]*int{
+		"PROMETHEUS_BOARD_MIN": &envRange.BoardMin,
+		"PROMETHEUS_BOARD_MAX": &envRange.BoardMax,
+		"PROMETHEUS_PON_MIN":   &envRange.PonMin,
+		"PROMETHEUS_PON_MAX":   &envRange.PonMax,
+	} {
+		if value, err := strconv.Atoi(os.Getenv(name)); err == nil && value > 0 {
+			*bound = value
+			rangeSet = true
+		}
+	}
+	if rangeSet {
+		cfg.Ranges = []config.ExporterRangeConfig{envRange}
+	}
+
+	if seconds, err := strconv.Atoi(os.Getenv("PROMETHEUS_INTERVAL_SECONDS")); err == nil {
+		cfg.IntervalSeconds = seconds
+	}
+	if seconds, err := strconv.Atoi(os.Getenv("PROMETHEUS_JITTER_SECONDS")); err == nil {
+		cfg.JitterSeconds = seconds
+	}
+	if workers, err := strconv.Atoi(os.Getenv("PROMETHEUS_WORKERS")); err == nil {
+		cfg.Workers = workers
+	}
+	if policy := os.Getenv("PROMETHEUS_OVERRUN_POLICY"); policy != "" {
+		cfg.OverrunPolicy = policy
+	}
+	if threshold, err := strconv.ParseFloat(os.Getenv("PROMETHEUS_RX_POWER_THRESHOLD"), 64); err == nil {
+		cfg.RxPowerThreshold = threshold
+	}
+	if labels := os.Getenv("PROMETHEUS_INFO_LABELS"); labels != "" {
+		cfg.InfoLabels = strings.Split(labels, ",")
+	}
+	return cfg
+}
+
+// schedulePons lists the PONs of the configured ranges once each, in board and PON order. Bounds outside
+// boards 1-2 and PONs 1-16 are clamped with a warning, inverted ranges and ranges without any PON of the OLT
+// are logged and skipped.
+func schedulePons(ranges []config.ExporterRangeConfig) []ponKey {
+	if len(ranges) == 0 {
+		ranges = []config.ExporterRangeConfig{defaultRange}
+	}
+
+	pons := make([]ponKey, 0)
+	seen := make(map[ponKey]bool)
+	for _, r := range ranges {
+		if r.BoardMin > r.BoardMax || r.PonMin > r.PonMax {
+			log.Warn().Interface("range", r).Msg("Skipping exporter range with min above max")
+			continue
+		}
+		if r.BoardMin > defaultRange.BoardMax || r.BoardMax < defaultRange.BoardMin ||
+			r.PonMin > defaultRange.PonMax || r.PonMax < defaultRange.PonMin {
+			log.Warn().Interface("range", r).Msg("Skipping exporter range outside boards 1-2 and PONs 1-16")
+			continue
+		}
+		if clamped := clampRange(r); clamped != r {
+			log.Warn().Interface("range", r).Interface("clamped", clamped).
+				Msg("Clamping exporter range to boards 1-2 and PONs 1-16")
+			r = clamped
+		}
+		for boardID := r.BoardMin; boardID <= r.BoardMax; boardID++ {
+			for ponID := r.PonMin; ponID <= r.PonMax; ponID++ {
+				key := ponKey{boardID, ponID}
+				if !seen[key] {
+					seen[key] = true
+					pons = append(pons, key)
+				}
+			}
+		}
+	}
+	return pons
+}
+
+// clampRange limits the bounds of a range to the boards and PONs of defaultRange.
+func clampRange(r config.ExporterRangeConfig) config.ExporterRangeConfig {
+	r.BoardMin = min(max(r.BoardMin, defaultRange.BoardMin), defaultRange.BoardMax)
+	r.BoardMax = min(max(r.BoardMax, defaultRange.BoardMin), defaultRange.BoardMax)
+	r.PonMin = min(max(r.PonMin, defaultRange.PonMin), defaultRange.PonMax)
+	r.PonMax = min(max(r.PonMax, defaultRange.PonMin), defaultRange.PonMax)
+	return r
+}
+
+// Start runs the collection cycles in the background until the context is done. A cycle starts every
+// interval after a random jitter, a cycle that is due while the previous one still runs is skipped or
+// queued according to the overrun policy. The measurements of every stored snapshot are written to the
+// sinks in the background.
+func (c *OnuCollector) Start(ctx context.Context) {
+	log.Info().Msgf("ONU exporter collects %d PONs every %s with %d workers, jitter %s, overrun policy %s",
+		len(c.pons), c.interval, c.workers, c.jitter, c.overrunPolicy)
+
+	if len(c.sinks) > 0 {
+		go c.writeSinks(ctx)
+	}
+	go c.run(ctx)
+}
+
+// run is the scheduler loop of Start.
+func (c *OnuCollector) run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	done := make(chan struct{})
+	running, queued := false, false
+
+	startCycle := func() {
+		running = true
+		go func() {
+			defer func() { done <- struct{}{} }()
+
+			if c.jitter > 0 {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(rand.N(c.jitter)):
+				}
+			}
+
+			log.Info().Msg("Starting ONU discovery and data collection for Prometheus")
+			c.collect(ctx)
+			log.Info().Msg("Finished ONU data collection")
+		}()
+	}
+
+	startCycle()
+	for {
+		select {
+		case <-ctx.Done():
+			if running {
+				<-done // Let the cycle see the cancellation before returning
+			}
+			return
+		case <-ticker.C:
+			switch {
+			case !running:
+				startCycle()
+			case c.overrunPolicy == OverrunPolicyQueue:
+				queued = true
+			default:
+				c.skippedCycles.Add(1)
+				log.Warn().Dur("interval", c.interval).Msg("Skipping ONU collection cycle, the previous one is still running")
+			}
+		case <-done:
+			running = false
+			if queued {
+				queued = false
+				startCycle()
+			}
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package exporter
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchedulePons(t *testing.T) {
+	pons := schedulePons([]config.ExporterRangeConfig{
+		{BoardMin: 1, BoardMax: 1, PonMin: 1, PonMax: 2},
+		{BoardMin: 1, BoardMax: 2, PonMin: 2, PonMax: 2},  // board 1 PON 2 is only listed once
+		{BoardMin: 2, BoardMax: 1, PonMin: 1, PonMax: 16}, // inverted, skipped
+	})
+	assert.Equal(t, []ponKey{{1, 1}, {1, 2}, {2, 2}}, pons)
+
+	assert.Len(t, schedulePons(nil), 32)
+
+	// Bounds outside the OLT are clamped instead of failing every cycle
+	pons = schedulePons([]config.ExporterRangeConfig{
+		{BoardMin: 2, BoardMax: 3, PonMin: 15, PonMax: 17},
+		{BoardMin: 0, BoardMax: 1, PonMin: 0, PonMax: 1},
+		{BoardMin: 3, BoardMax: 4, PonMin: 1, PonMax: 1}, // no board of the OLT, skipped
+	})
+	assert.Equal(t, []ponKey{{2, 15}, {2, 16}, {1, 1}}, pons)
+}
+
+func TestApplyEnv(t *testing.T) {
+	t.Setenv("PROMETHEUS_BOARD_MIN", "2")
+	t.Setenv("PROMETHEUS_PON_MAX", "8")
+	t.Setenv("PROMETHEUS_WORKERS", "8")
+	t.Setenv("PROMETHEUS_OVERRUN_POLICY", "queue")
+	t.Setenv("PROMETHEUS_INFO_LABELS", "name,serial_number")
+
+	cfg := ApplyEnv(config.ExporterConfig{
+		Ranges:          []config.ExporterRangeConfig{{BoardMin: 1, BoardMax: 1, PonMin: 1, PonMax: 4}},
+		IntervalSeconds: 60,
+	})
+	assert.Equal(t, []config.ExporterRangeConfig{{BoardMin: 2, BoardMax: 2, PonMin: 1, PonMax: 8}}, cfg.Ranges)
+	assert.Equal(t, 60, cfg.IntervalSeconds)
+	assert.Equal(t, 8, cfg.Workers)
+	assert.Equal(t, OverrunPolicyQueue, cfg.OverrunPolicy)
+	assert.Equal(t, []string{"name", "serial_number"}, cfg.InfoLabels)
+}
+
+func TestNewOnuCollectorDefaults(t *testing.T) {
+	c := NewOnuCollector(&fakeOnuUsecase{}, config.ExporterConfig{JitterSeconds: 60, OverrunPolicy: "drop"})
+	assert.Equal(t, defaultInterval, c.interval)
+	assert.Equal(t, time.Duration(0), c.jitter)
+	assert.Equal(t, defaultWorkers, c.workers)
+	assert.Equal(t, OverrunPolicySkip, c.overrunPolicy)
+	assert.Equal(t, defaultRxPowerThreshold, c.rxPowerThreshold)
+}
+
+// slowOnuUsecase counts the collection cycles, each of them outlasts the scheduler interval
+type slowOnuUsecase struct {
+	fakeOnuUsecase
+	cycles atomic.Int32
+}
+
+func (f *slowOnuUsecase) GetByBoardIDAndPonID(ctx context.Context, boardID, ponID int) ([]model.ONUInfoPerBoard, error) {
+	f.cycles.Add(1)
+	time.Sleep(50 * time.Millisecond)
+	return f.fakeOnuUsecase.GetByBoardIDAndPonID(ctx, boardID, ponID)
+}
+
+func runScheduler(t *testing.T, policy string) (*OnuCollector, *slowOnuUsecase) {
+	t.Helper()
+	fake := &slowOnuUsecase{}
+	cfg := testExporterConfig
+	cfg.OverrunPolicy = policy
+	c := NewOnuCollector(fake, cfg)
+	c.interval = 20 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	finished := make(chan struct{})
+	go func() {
+		c.run(ctx)
+		close(finished)
+	}()
+
+	time.Sleep(230 * time.Millisecond)
+	cancel()
+	<-finished
+	return c, fake
+}
+
+func TestSchedulerSkipsOverrunCycles(t *testing.T) {
+	c, fake := runScheduler(t, OverrunPolicySkip)
+
+	// A 50ms cycle on a 20ms interval misses ticks, they are dropped and counted
+	assert.GreaterOrEqual(t, fake.cycles.Load(), int32(2))
+	assert.LessOrEqual(t, fake.cycles.Load(), int32(5))
+	assert.Positive(t, c.skippedCycles.Load())
+	assert.NotNil(t, c.snapshot.Load())
+}
+
+func TestSchedulerQueuesOverrunCycles(t *testing.T) {
+	c, fake := runScheduler(t, OverrunPolicyQueue)
+
+	// Queued cycles run back to back, nothing is skipped
+	assert.GreaterOrEqual(t, fake.cycles.Load(), int32(3))
+	assert.Zero(t, c.skippedCycles.Load())
+}
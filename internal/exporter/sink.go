@@ -0,0 +1,50 @@
+package exporter
+
+import (
+	"context"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/rs/zerolog/log"
+)
+
+// Sink receives the measurements of every snapshot the collector stores, for example to write them to a
+// time-series database next to the Prometheus scrape.
+type Sink interface {
+	Name() string
+	Write(ctx context.Context, points []model.Measurement) error
+}
+
+// AddSink adds a sink that receives the measurements of every stored snapshot. It has to be called before Start.
+func (c *OnuCollector) AddSink(sink Sink) {
+	c.sinks = append(c.sinks, sink)
+}
+
+// publish hands the measurements of a stored snapshot to the sink writer. A slow sink must not hold up the
+// collection, so when the writer is still busy with an older snapshot the new one is dropped.
+func (c *OnuCollector) publish(s *snapshot) {
+	if len(c.sinks) == 0 {
+		return
+	}
+
+	select {
+	case c.sinkQueue <- s.measurements():
+	default:
+		log.Warn().Msg("Sinks are still writing the previous snapshot, dropping the measurements of this one")
+	}
+}
+
+// writeSinks writes the published measurements to every sink until the context is done.
+func (c *OnuCollector) writeSinks(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case points := <-c.sinkQueue:
+			for _, sink := range c.sinks {
+				if err := sink.Write(ctx, points); err != nil && ctx.Err() == nil {
+					log.Error().Err(err).Str("sink", sink.Name()).Int("points", len(points)).Msg("Failed to write measurements to sink")
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package exporter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSink passes the written measurements to a channel
+type fakeSink struct {
+	written chan []model.Measurement
+}
+
+func (f *fakeSink) Name() string {
+	return "fake"
+}
+
+func (f *fakeSink) Write(_ context.Context, points []model.Measurement) error {
+	f.written <- points
+	return nil
+}
+
+func TestSinkReceivesSnapshotMeasurements(t *testing.T) {
+	c := newTestCollector(&fakeOnuUsecase{})
+	sink := &fakeSink{written: make(chan []model.Measurement, 1)}
+	c.AddSink(sink)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c.Start(ctx)
+
+	var points []model.Measurement
+	select {
+	case points = <-sink.written:
+	case <-time.After(5 * time.Second):
+		t.Fatal("sink did not receive the first snapshot")
+	}
+
+	// Two ONUs with one UNI port each and the PON
+	byName := make(map[string][]model.Measurement)
+	for _, point := range points {
+		byName[point.Name] = append(byName[point.Name], point)
+	}
+	require.Len(t, byName[onuMeasurement], 2)
+	assert.Len(t, byName[onuUniMeasurement], 2)
+	require.Len(t, byName[ponMeasurement], 1)
+
+	onu := byName[onuMeasurement][0]
+	assert.Equal(t, "1", onu.Tags["board"])
+	assert.Equal(t, "1", onu.Tags["pon"])
+	assert.Equal(t, -20.71, onu.Fields["rx_power_dbm"])
+	assert.Equal(t, 1000.0, onu.Fields["upstream_bytes"])
+	assert.Equal(t, 6701.0, onu.Fields["gpon_optical_distance_meters"])
+	assert.Equal(t, time.Date(2025, 8, 11, 10, 0, 0, 0, time.UTC), onu.Time)
+
+	assert.Equal(t, "1", byName[onuUniMeasurement][0].Tags["port"])
+	assert.Equal(t, 1000.0, byName[onuUniMeasurement][0].Fields["speed_mbps"])
+	assert.Equal(t, 2.0, byName[ponMeasurement][0].Fields["registered_onus"])
+}
+
+func TestPublishDropsSnapshotWhileSinkIsBusy(t *testing.T) {
+	c := newTestCollector(&fakeOnuUsecase{})
+	c.AddSink(&fakeSink{})
+
+	// Nothing consumes the queue, the second snapshot is dropped instead of blocking the collection
+	c.collect(context.Background())
+	c.collect(context.Background())
+	assert.Len(t, c.sinkQueue, 1)
+}
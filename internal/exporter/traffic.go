@@ -0,0 +1,38 @@
+package exporter
+
+import (
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	onuUpstreamBytesDesc = prometheus.NewDesc(
+		"zte_onu_upstream_bytes_total",
+		"The number of bytes received by the OLT from the ONU.",
+		[]string{"board", "pon", "onu_id"}, nil,
+	)
+	onuDownstreamBytesDesc = prometheus.NewDesc(
+		"zte_onu_downstream_bytes_total",
+		"The number of bytes sent by the OLT to the ONU.",
+		[]string{"board", "pon", "onu_id"}, nil,
+	)
+	onuUpstreamPacketsDesc = prometheus.NewDesc(
+		"zte_onu_upstream_packets_total",
+		"The number of packets received by the OLT from the ONU.",
+		[]string{"board", "pon", "onu_id"}, nil,
+	)
+	onuDownstreamPacketsDesc = prometheus.NewDesc(
+		"zte_onu_downstream_packets_total",
+		"The number of packets sent by the OLT to the ONU.",
+		[]string{"board", "pon", "onu_id"}, nil,
+	)
+)
+
+// addTrafficMetrics adds the ONU traffic counters read from the OLT to b.
+// The OLT owns the counter values, so they are exported as constant counters instead of a CounterVec.
+func addTrafficMetrics(b *seriesBuilder, traffic model.ONUTraffic) {
+	b.counter(onuUpstreamBytesDesc, "upstream_bytes", float64(traffic.UpstreamBytes))
+	b.counter(onuDownstreamBytesDesc, "downstream_bytes", float64(traffic.DownstreamBytes))
+	b.counter(onuUpstreamPacketsDesc, "upstream_packets", float64(traffic.UpstreamPackets))
+	b.counter(onuDownstreamPacketsDesc, "downstream_packets", float64(traffic.DownstreamPackets))
+}
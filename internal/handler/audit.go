@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/audit"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/utils"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultAuditLimit = 100
+	maxAuditLimit     = 1000
+)
+
+// AuditHandler is a struct that represent the audit log handler
+type AuditHandler struct {
+	recorder *audit.Recorder
+}
+
+// NewAuditHandler will create an object that represent the audit log handler
+func NewAuditHandler(recorder *audit.Recorder) *AuditHandler {
+	return &AuditHandler{recorder: recorder}
+}
+
+// Query is a method to get the newest audit log entries, filtered by actor, action, board, pon, onu_id, result and
+// time range
+// example: http://localhost:8080/audit?action=update_empty_onu_id&board=1&from=2024-06-01T00:00:00Z&limit=50
+func (a *AuditHandler) Query(w http.ResponseWriter, r *http.Request) {
+
+	log.Info().Msg("Received a request to Query audit log")
+
+	query := r.URL.Query() // Get query parameters from the request
+
+	filter, err := parseAuditFilter(query)
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid audit filter")
+		utils.ErrorBadRequest(w, err) // error 400
+		return
+	}
+
+	// Validate limit value and return error 400 if limit is not between 1 and 1000
+	limit := defaultAuditLimit
+	if limitValue := query.Get("limit"); limitValue != "" {
+		limit, err = strconv.Atoi(limitValue)
+		if err != nil || limit < 1 || limit > maxAuditLimit {
+			log.Error().Err(err).Msg("Invalid 'limit' parameter")
+			utils.ErrorBadRequest(w, fmt.Errorf("invalid 'limit' parameter. It must be between 1 and %d",
+				maxAuditLimit)) // error 400
+			return
+		}
+	}
+
+	entries, err := a.recorder.Query(r.Context(), filter, limit)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to query audit log")
+		utils.ErrorInternalServerError(w, fmt.Errorf("cannot read audit log")) // error 500
+		return
+	}
+
+	// Convert result to JSON format according to WebResponse structure
+	response := utils.WebResponse{
+		Code:   http.StatusOK, // 200
+		Status: "OK",          // "OK"
+		Data:   entries,       // data
+	}
+
+	utils.SendJSONResponse(w, http.StatusOK, response) // 200
+}
+
+// Export is a method to download every audit log entry matching the filters as JSON lines, oldest first
+// example: http://localhost:8080/audit/export?actor=noc-dashboard
+func (a *AuditHandler) Export(w http.ResponseWriter, r *http.Request) {
+
+	log.Info().Msg("Received a request to Export audit log")
+
+	filter, err := parseAuditFilter(r.URL.Query())
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid audit filter")
+		utils.ErrorBadRequest(w, err) // error 400
+		return
+	}
+
+	// The headers are only written with the first entry, so an error before it can still be answered with 500
+	started := false
+	encoder := json.NewEncoder(w)
+	err = a.recorder.Export(r.Context(), filter, func(entry model.AuditEntry) error {
+		if !started {
+			writeAuditExportHeader(w)
+			started = true
+		}
+		return encoder.Encode(entry)
+	})
+	if err != nil && !started {
+		log.Error().Err(err).Msg("Failed to export audit log")
+		utils.ErrorInternalServerError(w, fmt.Errorf("cannot read audit log")) // error 500
+		return
+	}
+	if err != nil {
+		// The client already received a part of the file, stop it without a trailing error
+		log.Error().Err(err).Msg("Audit log export interrupted")
+		return
+	}
+
+	if !started {
+		writeAuditExportHeader(w)
+	}
+}
+
+// writeAuditExportHeader writes the headers of the JSON lines download
+func writeAuditExportHeader(w http.ResponseWriter) {
+	filename := "audit-" + time.Now().Format("20060102-150405") + ".jsonl"
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+	w.WriteHeader(http.StatusOK)
+}
+
+// parseAuditFilter parses the audit log filter query parameters
+func parseAuditFilter(query url.Values) (model.AuditFilter, error) {
+	filter := model.AuditFilter{
+		Actor:  query.Get("actor"),
+		Action: query.Get("action"),
+		Result: query.Get("result"),
+	}
+
+	// Validate result value, it must be success or failure
+	if filter.Result != "" && filter.Result != model.AuditResultSuccess && filter.Result != model.AuditResultFailure {
+		return model.AuditFilter{}, fmt.Errorf("invalid 'result' parameter. It must be success or failure")
+	}
+
+	// Validate board, pon and onu_id values, 1 or 2, between 1 and 16 and between 1 and 128
+	for _, param := range []struct {
+		name     string
+		max      int
+		value    *int
+		errorMsg string
+	}{
+		{"board", 2, &filter.Board, "invalid 'board' parameter. It must be 1 or 2"},
+		{"pon", 16, &filter.PON, "invalid 'pon' parameter. It must be between 1 and 16"},
+		{"onu_id", 128, &filter.OnuID, "invalid 'onu_id' parameter. It must be between 1 and 128"},
+	} {
+		value := query.Get(param.name)
+		if value == "" {
+			continue
+		}
+		id, err := strconv.Atoi(value)
+		if err != nil || id < 1 || id > param.max {
+			return model.AuditFilter{}, fmt.Errorf("%s", param.errorMsg)
+		}
+		*param.value = id
+	}
+
+	// Validate from and to values, they must be RFC 3339 times
+	for _, param := range []struct {
+		name  string
+		value *time.Time
+	}{
+		{"from", &filter.From},
+		{"to", &filter.To},
+	} {
+		value := query.Get(param.name)
+		if value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return model.AuditFilter{}, fmt.Errorf("invalid '%s' parameter. It must be an RFC 3339 time", param.name)
+		}
+		*param.value = t
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/events"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/utils"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// eventStreamHeartbeat keeps idle connections open through proxies
+	eventStreamHeartbeat = 15 * time.Second
+	// eventStreamRetry is the reconnection delay sent to the client, in milliseconds
+	eventStreamRetry = 5000
+)
+
+// EventHandler is a struct that represent the ONU event stream handler
+type EventHandler struct {
+	broker *events.Broker
+}
+
+// NewEventHandler will create an object that represent the ONU event stream handler
+func NewEventHandler(broker *events.Broker) *EventHandler {
+	return &EventHandler{broker: broker}
+}
+
+// Stream is a method to stream the onu events as server-sent events, optionally of a single board and pon.
+// A client resumes after the last event it received with the Last-Event-ID header or the last_event_id parameter.
+// example: http://localhost:8080/events/stream?board=1&pon=1
+func (e *EventHandler) Stream(w http.ResponseWriter, r *http.Request) {
+
+	log.Info().Msg("Received a request to Stream events")
+
+	query := r.URL.Query() // Get query parameters from the request
+
+	// Validate optional board value and return error 400 if board is not 1 or 2
+	var filter events.Filter
+	if board := query.Get("board"); board != "" {
+		var err error
+		filter.Board, err = strconv.Atoi(board)
+		if err != nil || (filter.Board != 1 && filter.Board != 2) {
+			log.Error().Err(err).Msg("Invalid 'board' parameter")
+			utils.ErrorBadRequest(w, fmt.Errorf("invalid 'board' parameter. It must be 1 or 2")) // error 400
+			return
+		}
+	}
+
+	// Validate optional pon value and return error 400 if pon is not between 1 and 16
+	if pon := query.Get("pon"); pon != "" {
+		var err error
+		filter.PON, err = strconv.Atoi(pon)
+		if err != nil || filter.PON < 1 || filter.PON > 16 {
+			log.Error().Err(err).Msg("Invalid 'pon' parameter")
+			utils.ErrorBadRequest(w, fmt.Errorf("invalid 'pon' parameter. It must be between 1 and 16")) // error 400
+			return
+		}
+	}
+
+	// Validate optional last event ID, the header sent by a reconnecting EventSource wins over the parameter
+	var lastEventID uint64
+	lastEventIDValue := r.Header.Get("Last-Event-ID")
+	if lastEventIDValue == "" {
+		lastEventIDValue = query.Get("last_event_id")
+	}
+	if lastEventIDValue != "" {
+		var err error
+		lastEventID, err = strconv.ParseUint(lastEventIDValue, 10, 64)
+		if err != nil {
+			log.Error().Err(err).Msg("Invalid 'Last-Event-ID' value")
+			utils.ErrorBadRequest(w, fmt.Errorf("invalid 'Last-Event-ID' value. It must be an event id")) // error 400
+			return
+		}
+	}
+
+	controller := http.NewResponseController(w)
+
+	backlog, eventCh, unsubscribe := e.broker.Subscribe(filter, lastEventID)
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no") // Disable nginx response buffering
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", eventStreamRetry); err != nil {
+		return
+	}
+	for _, event := range backlog {
+		if err := writeServerSentEvent(w, event); err != nil {
+			return
+		}
+	}
+	if err := controller.Flush(); err != nil {
+		log.Error().Err(err).Msg("Streaming is not supported")
+		return
+	}
+
+	heartbeat := time.NewTicker(eventStreamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-eventCh:
+			// Closed when the client is too slow or the server stops, the client reconnects with its last event ID
+			if !ok {
+				return
+			}
+			if err := writeServerSentEvent(w, event); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+
+		if err := controller.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// writeServerSentEvent writes an onu event in the server-sent events format
+func writeServerSentEvent(w http.ResponseWriter, event model.OnuEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+	return err
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/health"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/utils"
+	"github.com/rs/zerolog/log"
+)
+
+// HealthHandler is a struct that represent the liveness and readiness probe handler
+type HealthHandler struct {
+	checker *health.Checker
+}
+
+// NewHealthHandler will create an object that represent the liveness and readiness probe handler
+func NewHealthHandler(checker *health.Checker) *HealthHandler {
+	return &HealthHandler{checker: checker}
+}
+
+// Liveness is a method to tell that the service is running, it does not check the dependencies
+// example: http://localhost:8080/healthz
+func (h *HealthHandler) Liveness(w http.ResponseWriter, _ *http.Request) {
+	response := utils.WebResponse{
+		Code:   http.StatusOK, // 200
+		Status: "OK",          // "OK"
+		Data:   model.Readiness{Status: model.HealthStatusUp, Dependencies: []model.DependencyStatus{}},
+	}
+
+	utils.SendJSONResponse(w, http.StatusOK, response) // 200
+}
+
+// Readiness is a method to check every dependency of the service, the OLT over SNMP and Redis, and return 503 when
+// one of them is down
+// example: http://localhost:8080/readyz
+func (h *HealthHandler) Readiness(w http.ResponseWriter, r *http.Request) {
+	readiness := h.checker.Readiness(r.Context())
+
+	// Return error 503 with the status of every dependency when a dependency is down
+	if readiness.Status != model.HealthStatusUp {
+		log.Warn().Interface("dependencies", readiness.Dependencies).Msg("Service is not ready")
+		response := utils.WebResponse{
+			Code:   http.StatusServiceUnavailable, // 503
+			Status: "Service Unavailable",         // "Service Unavailable"
+			Data:   readiness,                     // data
+		}
+		utils.SendJSONResponse(w, http.StatusServiceUnavailable, response) // 503
+		return
+	}
+
+	response := utils.WebResponse{
+		Code:   http.StatusOK, // 200
+		Status: "OK",          // "OK"
+		Data:   readiness,     // data
+	}
+
+	utils.SendJSONResponse(w, http.StatusOK, response) // 200
+}
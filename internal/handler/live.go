@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/websocket"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/live"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/middleware"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/utils"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	livePingInterval = 30 * time.Second
+	liveWriteTimeout = 10 * time.Second
+)
+
+// LiveHandler is a struct that represent the live optical meter handler
+type LiveHandler struct {
+	hub      *live.Hub
+	upgrader websocket.Upgrader
+}
+
+// NewLiveHandler will create an object that represent the live optical meter handler. Browsers may only open a
+// session from the same origin or from the origins allowed by CorsMiddleware
+func NewLiveHandler(hub *live.Hub, allowedOrigins []string) *LiveHandler {
+	return &LiveHandler{
+		hub: hub,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+			CheckOrigin: func(r *http.Request) bool {
+				origin := r.Header.Get("Origin")
+				if origin == "" {
+					return true // not a browser
+				}
+				if u, err := url.Parse(origin); err == nil && strings.EqualFold(u.Host, r.Host) {
+					return true
+				}
+				return middleware.OriginAllowed(allowedOrigins, origin)
+			},
+		},
+	}
+}
+
+// OnuLive is a method to stream the rx power, tx power and status of an onu over a WebSocket while the client is
+// connected
+// example: ws://localhost:8080/board/1/pon/1/onu/1/live
+func (l *LiveHandler) OnuLive(w http.ResponseWriter, r *http.Request) {
+
+	boardID := chi.URLParam(r, "board_id") // 1 or 2
+	ponID := chi.URLParam(r, "pon_id")     // 1 - 16
+	onuID := chi.URLParam(r, "onu_id")     // 1 - 128
+
+	boardIDInt, err := strconv.Atoi(boardID) // convert string to int
+
+	log.Info().Msg("Received a request to OnuLive")
+
+	// Validate boardIDInt value and return error 400 if boardIDInt is not 1 or 2
+	if err != nil || (boardIDInt != 1 && boardIDInt != 2) {
+		log.Error().Err(err).Msg("Invalid 'board_id' parameter")
+		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'board_id' parameter. It must be 1 or 2")) // error 400
+		return
+	}
+
+	ponIDInt, err := strconv.Atoi(ponID) // convert string to int
+
+	// Validate ponIDInt value and return error 400 if ponIDInt is not between 1 and 16
+	if err != nil || ponIDInt < 1 || ponIDInt > 16 {
+		log.Error().Err(err).Msg("Invalid 'pon_id' parameter")
+		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'pon_id' parameter. It must be between 1 and 16")) // error 400
+		return
+	}
+
+	onuIDInt, err := strconv.Atoi(onuID) // convert string to int
+
+	// Validate onuIDInt value and return error 400 if onuIDInt is not between 1 and 128
+	if err != nil || onuIDInt < 1 || onuIDInt > 128 {
+		log.Error().Err(err).Msg("Invalid 'onu_id' parameter")
+		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'onu_id' parameter. It must be between 1 and 128")) // error 400
+		return
+	}
+
+	// Return error 503 when the OLT already has the maximum number of live sessions
+	readings, leave, err := l.hub.Join(boardIDInt, ponIDInt, onuIDInt)
+	if errors.Is(err, live.ErrTooManySessions) {
+		log.Warn().Msg("Too many live sessions")
+		utils.ErrorServiceUnavailable(w, fmt.Errorf("too many live sessions, try again later")) // error 503
+		return
+	}
+	defer leave()
+
+	conn, err := l.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// The upgrader already answered with an error
+		log.Error().Err(err).Msg("Failed to upgrade to WebSocket")
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	// Read until the client closes the connection, the client does not send anything else
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ping := time.NewTicker(livePingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case reading := <-readings:
+			_ = conn.SetWriteDeadline(time.Now().Add(liveWriteTimeout))
+			if err := conn.WriteJSON(reading); err != nil {
+				return
+			}
+		case <-ping.C:
+			err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(liveWriteTimeout))
+			if err != nil {
+				return
+			}
+		}
+	}
+}
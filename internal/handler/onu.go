@@ -1,11 +1,16 @@
 package handler
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/audit"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
 	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/usecase"
 	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/utils"
 	"github.com/megadata-dev/go-snmp-olt-zte-c320/pkg/pagination"
@@ -15,7 +20,12 @@ import (
 // OnuHandlerInterface is an interface that represent the auth's handler contract
 type OnuHandlerInterface interface {
 	GetByBoardIDAndPonID(w http.ResponseWriter, r *http.Request)
+	GetAll(w http.ResponseWriter, r *http.Request)
 	GetByBoardIDPonIDAndOnuID(w http.ResponseWriter, r *http.Request)
+	GetOnuUniPorts(w http.ResponseWriter, r *http.Request)
+	GetOnuFirmwareInventory(w http.ResponseWriter, r *http.Request)
+	ExportOnuInventory(w http.ResponseWriter, r *http.Request)
+	GetTopTalkers(w http.ResponseWriter, r *http.Request)
 	GetEmptyOnuID(w http.ResponseWriter, r *http.Request)
 	GetOnuIDAndSerialNumber(w http.ResponseWriter, r *http.Request)
 	UpdateEmptyOnuID(w http.ResponseWriter, r *http.Request)
@@ -34,6 +44,7 @@ func NewOnuHandler(ponUsecase usecase.OnuUseCaseInterface) *OnuHandler {
 
 // GetByBoardIDAndPonID is a method to get onu info by board id and pon id
 // example: http://localhost:8080/board/1/pon/1
+// example with filters: http://localhost:8080/board/1/pon/1?status=LOS,Offline&rx_lt=-27&sort=rx_power:asc
 func (o *OnuHandler) GetByBoardIDAndPonID(w http.ResponseWriter, r *http.Request) {
 
 	boardID := chi.URLParam(r, "board_id") // 1 or 2
@@ -41,11 +52,11 @@ func (o *OnuHandler) GetByBoardIDAndPonID(w http.ResponseWriter, r *http.Request
 
 	boardIDInt, err := strconv.Atoi(boardID) // convert string to int
 
-	log.Info().Msg("Received a request to GetByBoardIDAndPonID")
+	log.Info().Ctx(r.Context()).Msg("Received a request to GetByBoardIDAndPonID")
 
 	// Validate boardIDInt value and return error 400 if boardIDInt is not 1 or 2
 	if err != nil || (boardIDInt != 1 && boardIDInt != 2) {
-		log.Error().Err(err).Msg("Invalid 'board_id' parameter")
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'board_id' parameter")
 		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'board_id' parameter. It must be 1 or 2")) // error 400
 		return
 	}
@@ -54,31 +65,32 @@ func (o *OnuHandler) GetByBoardIDAndPonID(w http.ResponseWriter, r *http.Request
 
 	// Validate ponIDInt value and return error 400 if ponIDInt is not between 1 and 8
 	if err != nil || ponIDInt < 1 || ponIDInt > 16 {
-		log.Error().Err(err).Msg("Invalid 'pon_id' parameter")
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'pon_id' parameter")
 		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'pon_id' parameter. It must be between 1 and 16")) // error 400
 		return
 	}
 
 	query := r.URL.Query() // Get query parameters from the request
 
-	log.Debug().Interface("query_parameters", query).Msg("Received query parameters")
+	log.Debug().Ctx(r.Context()).Interface("query_parameters", query).Msg("Received query parameters")
 
-	//Validate query parameters and return error 400 if query parameters is not "onu_id" or empty query parameters
-	if len(query) > 0 && query["onu_id"] == nil {
-		log.Error().Msg("Invalid query parameter")
-		utils.ErrorBadRequest(w, fmt.Errorf("invalid query parameter")) // error 400
+	// Validate filter and sort query parameters and return error 400 if a query parameter is invalid
+	filter, err := utils.ParseOnuListFilter(query)
+	if err != nil {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid query parameter")
+		utils.ErrorBadRequest(w, err) // error 400
 		return
 	}
 
-	// Call usecase to get data from SNMP
-	onuInfoList, err := o.ponUsecase.GetByBoardIDAndPonID(r.Context(), boardIDInt, ponIDInt)
+	// Call usecase to get data from SNMP or Redis and filter it
+	onuInfoList, err := o.ponUsecase.GetByBoardIDAndPonIDWithFilter(r.Context(), boardIDInt, ponIDInt, filter)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to get data from SNMP")
+		log.Error().Ctx(r.Context()).Err(err).Msg("Failed to get data from SNMP")
 		utils.ErrorInternalServerError(w, fmt.Errorf("cannot get data from snmp")) // error 500
 		return
 	}
 
-	log.Info().Msg("Successfully retrieved data from SNMP")
+	log.Info().Ctx(r.Context()).Msg("Successfully retrieved data from SNMP")
 
 	/*
 		Validate onuInfoList value
@@ -86,7 +98,7 @@ func (o *OnuHandler) GetByBoardIDAndPonID(w http.ResponseWriter, r *http.Request
 	*/
 
 	if len(onuInfoList) == 0 {
-		log.Warn().Msg("Data not found")
+		log.Warn().Ctx(r.Context()).Msg("Data not found")
 		utils.ErrorNotFound(w, fmt.Errorf("data not found")) // error 404
 		return
 	}
@@ -102,8 +114,58 @@ func (o *OnuHandler) GetByBoardIDAndPonID(w http.ResponseWriter, r *http.Request
 
 }
 
+// GetAll is a method to get onu info of every pon on every board, with the same filters as GetByBoardIDAndPonID
+// example: http://localhost:8080/onu?status=LOS,Offline&sort=rx_power:asc
+func (o *OnuHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+
+	log.Info().Ctx(r.Context()).Msg("Received a request to GetAll")
+
+	query := r.URL.Query() // Get query parameters from the request
+
+	log.Debug().Ctx(r.Context()).Interface("query_parameters", query).Msg("Received query parameters")
+
+	// Validate filter and sort query parameters and return error 400 if a query parameter is invalid
+	filter, err := utils.ParseOnuListFilter(query)
+	if err != nil {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid query parameter")
+		utils.ErrorBadRequest(w, err) // error 400
+		return
+	}
+
+	// Call usecase to get data from SNMP or Redis and filter it
+	onuInfoList, err := o.ponUsecase.GetAllWithFilter(r.Context(), filter)
+	if err != nil {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Failed to get data from SNMP")
+		utils.ErrorInternalServerError(w, fmt.Errorf("cannot get data from snmp")) // error 500
+		return
+	}
+
+	log.Info().Ctx(r.Context()).Msg("Successfully retrieved data from SNMP")
+
+	/*
+		Validate onuInfoList value
+		If onuInfoList is empty, return error 404
+	*/
+
+	if len(onuInfoList) == 0 {
+		log.Warn().Ctx(r.Context()).Msg("Data not found")
+		utils.ErrorNotFound(w, fmt.Errorf("data not found")) // error 404
+		return
+	}
+
+	// Convert result to JSON format according to WebResponse structure
+	response := utils.WebResponse{
+		Code:   http.StatusOK, // 200
+		Status: "OK",          // "OK"
+		Data:   onuInfoList,   // data
+	}
+
+	utils.SendJSONResponse(w, http.StatusOK, response) // 200
+}
+
 // GetByBoardIDPonIDAndOnuID is a method to get onu info by board id, pon id, and onu id
 // example: http://localhost:8080/board/1/pon/1/onu
+// example with field selection: http://localhost:8080/board/1/pon/1/onu/1?fields=status,rx_power,tx_power
 func (o *OnuHandler) GetByBoardIDPonIDAndOnuID(w http.ResponseWriter, r *http.Request) {
 
 	boardID := chi.URLParam(r, "board_id") // 1 or 2
@@ -112,11 +174,11 @@ func (o *OnuHandler) GetByBoardIDPonIDAndOnuID(w http.ResponseWriter, r *http.Re
 
 	boardIDInt, err := strconv.Atoi(boardID) // convert string to int
 
-	log.Info().Msg("Received a request to GetByBoardIDPonIDAndOnuID")
+	log.Info().Ctx(r.Context()).Msg("Received a request to GetByBoardIDPonIDAndOnuID")
 
 	// Validate boardIDInt value and return error 400 if boardIDInt is not 1 or 2
 	if err != nil || (boardIDInt != 1 && boardIDInt != 2) {
-		log.Error().Err(err).Msg("Invalid 'board_id' parameter")
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'board_id' parameter")
 		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'board_id' parameter. It must be 1 or 2")) // error 400
 		return
 	}
@@ -125,7 +187,7 @@ func (o *OnuHandler) GetByBoardIDPonIDAndOnuID(w http.ResponseWriter, r *http.Re
 
 	// Validate ponIDInt value and return error 400 if ponIDInt is not between 1 and 8
 	if err != nil || ponIDInt < 1 || ponIDInt > 16 {
-		log.Error().Err(err).Msg("Invalid 'pon_id' parameter")
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'pon_id' parameter")
 		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'pon_id' parameter. It must be between 1 and 16")) // error 400
 		return
 	}
@@ -134,21 +196,32 @@ func (o *OnuHandler) GetByBoardIDPonIDAndOnuID(w http.ResponseWriter, r *http.Re
 
 	// Validate onuIDInt value and return error 400 if onuIDInt is not between 1 and 128
 	if err != nil || onuIDInt < 1 || onuIDInt > 128 {
-		log.Error().Err(err).Msg("Invalid 'onu_id' parameter")
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'onu_id' parameter")
 		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'onu_id' parameter. It must be between 1 and 128")) // error 400
 		return
 	}
 
+	// Optional field selection, example: ?fields=status,rx_power,tx_power
+	fields := utils.ParseFieldsQuery(r.URL.Query().Get("fields"))
+
 	// Call usecase to get data from SNMP
-	onuInfoList, err := o.ponUsecase.GetByBoardIDPonIDAndOnuID(boardIDInt, ponIDInt, onuIDInt)
+	onuInfoList, err := o.ponUsecase.GetByBoardIDPonIDAndOnuIDWithFields(r.Context(), boardIDInt, ponIDInt, onuIDInt, fields)
+
+	// Return error 400 if a selected field does not exist
+	if errors.Is(err, usecase.ErrUnknownOnuField) {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'fields' parameter")
+		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'fields' parameter, %v. Valid fields are: name, %s",
+			err, strings.Join(usecase.OnuDetailFields(), ", "))) // error 400
+		return
+	}
 
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to get data from SNMP")
+		log.Error().Ctx(r.Context()).Err(err).Msg("Failed to get data from SNMP")
 		utils.ErrorInternalServerError(w, fmt.Errorf("cannot get data from snmp")) // error 500
 		return
 	}
 
-	log.Info().Msg("Successfully retrieved data from SNMP")
+	log.Info().Ctx(r.Context()).Msg("Successfully retrieved data from SNMP")
 
 	/*
 		Validate onuInfoList value
@@ -157,16 +230,341 @@ func (o *OnuHandler) GetByBoardIDPonIDAndOnuID(w http.ResponseWriter, r *http.Re
 	*/
 
 	if onuInfoList.Board == 0 && onuInfoList.PON == 0 && onuInfoList.ID == 0 {
-		log.Error().Msg("Data not found")
+		log.Error().Ctx(r.Context()).Msg("Data not found")
 		utils.ErrorNotFound(w, fmt.Errorf("data not found")) // error 404
 		return
 	}
 
+	// Keep only the selected fields in the response
+	var data interface{} = onuInfoList
+	if len(fields) > 0 {
+		data, err = utils.SelectJSONFields(onuInfoList, fields)
+		if err != nil {
+			log.Error().Ctx(r.Context()).Err(err).Msg("Failed to select fields")
+			utils.ErrorInternalServerError(w, fmt.Errorf("cannot select fields")) // error 500
+			return
+		}
+	}
+
 	// Convert a result to JSON format according to WebResponse structure
 	response := utils.WebResponse{
 		Code:   http.StatusOK, // 200
 		Status: "OK",          // "OK"
-		Data:   onuInfoList,   // data
+		Data:   data,          // data
+	}
+
+	utils.SendJSONResponse(w, http.StatusOK, response) // 200
+}
+
+// GetOnuUniPorts is a method to get the ethernet UNI port status of an onu by board id, pon id, and onu id
+// example: http://localhost:8080/board/1/pon/1/onu/1/uni
+func (o *OnuHandler) GetOnuUniPorts(w http.ResponseWriter, r *http.Request) {
+
+	boardID := chi.URLParam(r, "board_id") // 1 or 2
+	ponID := chi.URLParam(r, "pon_id")     // 1 - 8
+	onuID := chi.URLParam(r, "onu_id")     // 1 - 128
+
+	boardIDInt, err := strconv.Atoi(boardID) // convert string to int
+
+	log.Info().Ctx(r.Context()).Msg("Received a request to GetOnuUniPorts")
+
+	// Validate boardIDInt value and return error 400 if boardIDInt is not 1 or 2
+	if err != nil || (boardIDInt != 1 && boardIDInt != 2) {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'board_id' parameter")
+		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'board_id' parameter. It must be 1 or 2")) // error 400
+		return
+	}
+
+	ponIDInt, err := strconv.Atoi(ponID) // convert string to int
+
+	// Validate ponIDInt value and return error 400 if ponIDInt is not between 1 and 8
+	if err != nil || ponIDInt < 1 || ponIDInt > 16 {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'pon_id' parameter")
+		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'pon_id' parameter. It must be between 1 and 16")) // error 400
+		return
+	}
+
+	onuIDInt, err := strconv.Atoi(onuID) // convert string to int
+
+	// Validate onuIDInt value and return error 400 if onuIDInt is not between 1 and 128
+	if err != nil || onuIDInt < 1 || onuIDInt > 128 {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'onu_id' parameter")
+		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'onu_id' parameter. It must be between 1 and 128")) // error 400
+		return
+	}
+
+	// Call usecase to get UNI port status from SNMP
+	uniPortList, err := o.ponUsecase.GetOnuUniPorts(r.Context(), boardIDInt, ponIDInt, onuIDInt)
+
+	if err != nil {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Failed to get data from SNMP")
+		utils.ErrorInternalServerError(w, fmt.Errorf("cannot get data from snmp")) // error 500
+		return
+	}
+
+	log.Info().Ctx(r.Context()).Msg("Successfully retrieved data from SNMP")
+
+	/*
+		Validate uniPortList value
+		If uniPortList is empty, the ONU is not registered or does not report any UNI port, return error 404
+	*/
+
+	if len(uniPortList) == 0 {
+		log.Warn().Ctx(r.Context()).Msg("Data not found")
+		utils.ErrorNotFound(w, fmt.Errorf("data not found")) // error 404
+		return
+	}
+
+	// Convert a result to JSON format according to WebResponse structure
+	response := utils.WebResponse{
+		Code:   http.StatusOK, // 200
+		Status: "OK",          // "OK"
+		Data:   uniPortList,   // data
+	}
+
+	utils.SendJSONResponse(w, http.StatusOK, response) // 200
+}
+
+// GetOnuFirmwareInventory is a method to get onu count grouped by onu type and firmware version
+// example: http://localhost:8080/inventory/firmware?onu_type=F670LV7.1&version=V7.0.10P6N7
+func (o *OnuHandler) GetOnuFirmwareInventory(w http.ResponseWriter, r *http.Request) {
+
+	log.Info().Ctx(r.Context()).Msg("Received a request to GetOnuFirmwareInventory")
+
+	query := r.URL.Query() // Get query parameters from the request
+
+	log.Debug().Ctx(r.Context()).Interface("query_parameters", query).Msg("Received query parameters")
+
+	// Validate query parameters and return error 400 if query parameters is not "onu_type" or "version"
+	for param := range query {
+		if param != "onu_type" && param != "version" {
+			log.Error().Ctx(r.Context()).Msg("Invalid query parameter")
+			utils.ErrorBadRequest(w, fmt.Errorf("invalid query parameter '%s'", param)) // error 400
+			return
+		}
+	}
+
+	// Call usecase to get firmware inventory from SNMP or Redis
+	inventoryList, err := o.ponUsecase.GetOnuFirmwareInventory(r.Context(), query.Get("onu_type"), query.Get("version"))
+	if err != nil {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Failed to get data from SNMP")
+		utils.ErrorInternalServerError(w, fmt.Errorf("cannot get data from snmp")) // error 500
+		return
+	}
+
+	log.Info().Ctx(r.Context()).Msg("Successfully retrieved data from SNMP")
+
+	/*
+		Validate inventoryList value
+		If inventoryList is empty, no ONU matches the filter, return error 404
+	*/
+
+	if len(inventoryList) == 0 {
+		log.Warn().Ctx(r.Context()).Msg("Data not found")
+		utils.ErrorNotFound(w, fmt.Errorf("data not found")) // error 404
+		return
+	}
+
+	// Convert result to JSON format according to WebResponse structure
+	response := utils.WebResponse{
+		Code:   http.StatusOK, // 200
+		Status: "OK",          // "OK"
+		Data:   inventoryList, // data
+	}
+
+	utils.SendJSONResponse(w, http.StatusOK, response) // 200
+}
+
+// ExportOnuInventory is a method to export every onu as a csv or xlsx file, streamed pon by pon
+// example: http://localhost:8080/export?format=xlsx&board=1&status=Online&detail=true
+func (o *OnuHandler) ExportOnuInventory(w http.ResponseWriter, r *http.Request) {
+
+	log.Info().Ctx(r.Context()).Msg("Received a request to ExportOnuInventory")
+
+	query := r.URL.Query() // Get query parameters from the request
+
+	log.Debug().Ctx(r.Context()).Interface("query_parameters", query).Msg("Received query parameters")
+
+	// Validate format value and return error 400 if format is not csv or xlsx, csv is the default
+	format := query.Get("format")
+	if format == "" {
+		format = utils.ExportFormatCSV
+	}
+	if format != utils.ExportFormatCSV && format != utils.ExportFormatXLSX {
+		log.Error().Ctx(r.Context()).Msg("Invalid 'format' parameter")
+		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'format' parameter. It must be csv or xlsx")) // error 400
+		return
+	}
+
+	// Validate optional board value and return error 400 if board is not 1 or 2
+	boardIDInt := 0
+	if board := query.Get("board"); board != "" {
+		var err error
+		boardIDInt, err = strconv.Atoi(board)
+		if err != nil || (boardIDInt != 1 && boardIDInt != 2) {
+			log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'board' parameter")
+			utils.ErrorBadRequest(w, fmt.Errorf("invalid 'board' parameter. It must be 1 or 2")) // error 400
+			return
+		}
+	}
+
+	// Validate optional pon value and return error 400 if pon is not between 1 and 16
+	ponIDInt := 0
+	if pon := query.Get("pon"); pon != "" {
+		var err error
+		ponIDInt, err = strconv.Atoi(pon)
+		if err != nil || ponIDInt < 1 || ponIDInt > 16 {
+			log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'pon' parameter")
+			utils.ErrorBadRequest(w, fmt.Errorf("invalid 'pon' parameter. It must be between 1 and 16")) // error 400
+			return
+		}
+	}
+
+	// Validate optional detail value and return error 400 if detail is not a boolean, without detail only the
+	// columns of the cached PON list are exported
+	detail := false
+	if detailParam := query.Get("detail"); detailParam != "" {
+		var err error
+		detail, err = strconv.ParseBool(detailParam)
+		if err != nil {
+			log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'detail' parameter")
+			utils.ErrorBadRequest(w, fmt.Errorf("invalid 'detail' parameter. It must be true or false")) // error 400
+			return
+		}
+	}
+	columns, row := utils.OnuListColumns, utils.OnuListRow
+	if detail {
+		columns, row = utils.OnuInventoryColumns, utils.OnuInventoryRow
+	}
+
+	// Validate filter query parameters and return error 400 if a query parameter is invalid
+	filter, err := utils.ParseOnuListFilter(query, "format", "board", "pon", "detail")
+	if err != nil {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid query parameter")
+		utils.ErrorBadRequest(w, err) // error 400
+		return
+	}
+
+	// The file is started on the first row, so errors before any row can still be answered with JSON
+	var writer utils.RowWriter
+	var rowCount int
+
+	err = o.ponUsecase.ExportOnuInventory(r.Context(), boardIDInt, ponIDInt, filter, detail, func(onuInfo model.ONUCustomerInfo) error {
+		if writer == nil {
+			filename := "onu-inventory-" + time.Now().Format("20060102-150405") + "." + format
+			w.Header().Set("Content-Type", utils.ExportContentType(format))
+			w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+			w.WriteHeader(http.StatusOK)
+
+			var err error
+			if writer, err = utils.NewRowWriter(w, format); err != nil {
+				return err
+			}
+			if err := writer.WriteRow(columns); err != nil {
+				return err
+			}
+		}
+
+		rowCount++
+		return writer.WriteRow(row(onuInfo))
+	})
+
+	// Nothing written yet, answer with a JSON error
+	if writer == nil {
+		if err != nil {
+			log.Error().Ctx(r.Context()).Err(err).Msg("Failed to get data from SNMP")
+			utils.ErrorInternalServerError(w, fmt.Errorf("cannot get data from snmp")) // error 500
+			return
+		}
+
+		log.Warn().Ctx(r.Context()).Msg("Data not found")
+		utils.ErrorNotFound(w, fmt.Errorf("data not found")) // error 404
+		return
+	}
+
+	// The status is already sent, an error can only be logged and leaves the file truncated
+	if err != nil {
+		log.Error().Ctx(r.Context()).Err(err).Int("rows", rowCount).Msg("Failed to export ONU inventory")
+		return
+	}
+
+	if err := writer.Close(); err != nil {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Failed to finish ONU inventory export")
+		return
+	}
+
+	log.Info().Ctx(r.Context()).Int("rows", rowCount).Msg("Successfully exported ONU inventory")
+}
+
+// GetTopTalkers is a method to get the onu with the highest traffic rate by board id and pon id
+// example: http://localhost:8080/board/1/pon/1/top_talkers?limit=10&direction=downstream
+func (o *OnuHandler) GetTopTalkers(w http.ResponseWriter, r *http.Request) {
+
+	boardID := chi.URLParam(r, "board_id") // 1 or 2
+	ponID := chi.URLParam(r, "pon_id")     // 1 - 8
+
+	boardIDInt, err := strconv.Atoi(boardID) // convert string to int
+
+	log.Info().Ctx(r.Context()).Msg("Received a request to GetTopTalkers")
+
+	// Validate boardIDInt value and return error 400 if boardIDInt is not 1 or 2
+	if err != nil || (boardIDInt != 1 && boardIDInt != 2) {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'board_id' parameter")
+		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'board_id' parameter. It must be 1 or 2")) // error 400
+		return
+	}
+
+	ponIDInt, err := strconv.Atoi(ponID) // convert string to int
+
+	// Validate ponIDInt value and return error 400 if ponIDInt is not between 1 and 8
+	if err != nil || ponIDInt < 1 || ponIDInt > 16 {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'pon_id' parameter")
+		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'pon_id' parameter. It must be between 1 and 16")) // error 400
+		return
+	}
+
+	query := r.URL.Query() // Get query parameters from the request
+
+	// Validate limit value and return error 400 if limit is not between 1 and 128
+	limit := 10
+	if query.Get("limit") != "" {
+		limit, err = strconv.Atoi(query.Get("limit"))
+		if err != nil || limit < 1 || limit > 128 {
+			log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'limit' parameter")
+			utils.ErrorBadRequest(w, fmt.Errorf("invalid 'limit' parameter. It must be between 1 and 128")) // error 400
+			return
+		}
+	}
+
+	// Validate direction value and return error 400 if direction is not upstream, downstream or empty
+	direction := query.Get("direction")
+	if direction != "" && direction != "upstream" && direction != "downstream" {
+		log.Error().Ctx(r.Context()).Msg("Invalid 'direction' parameter")
+		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'direction' parameter. It must be upstream or downstream")) // error 400
+		return
+	}
+
+	// Call usecase to get traffic from SNMP
+	trafficList, err := o.ponUsecase.GetTopTalkers(r.Context(), boardIDInt, ponIDInt, limit, direction)
+	if err != nil {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Failed to get data from SNMP")
+		utils.ErrorInternalServerError(w, fmt.Errorf("cannot get data from snmp")) // error 500
+		return
+	}
+
+	log.Info().Ctx(r.Context()).Msg("Successfully retrieved data from SNMP")
+
+	if len(trafficList) == 0 {
+		log.Warn().Ctx(r.Context()).Msg("Data not found")
+		utils.ErrorNotFound(w, fmt.Errorf("data not found")) // error 404
+		return
+	}
+
+	// Convert result to JSON format according to WebResponse structure
+	response := utils.WebResponse{
+		Code:   http.StatusOK, // 200
+		Status: "OK",          // "OK"
+		Data:   trafficList,   // data
 	}
 
 	utils.SendJSONResponse(w, http.StatusOK, response) // 200
@@ -181,11 +579,11 @@ func (o *OnuHandler) GetEmptyOnuID(w http.ResponseWriter, r *http.Request) {
 
 	boardIDInt, err := strconv.Atoi(boardID) // convert string to int
 
-	log.Info().Msg("Received a request to GetEmptyOnuID")
+	log.Info().Ctx(r.Context()).Msg("Received a request to GetEmptyOnuID")
 
 	// Validate boardIDInt value and return error 400 if boardIDInt is not 1 or 2
 	if err != nil || (boardIDInt != 1 && boardIDInt != 2) {
-		log.Error().Err(err).Msg("Invalid 'board_id' parameter")
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'board_id' parameter")
 		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'board_id' parameter. It must be 1 or 2")) // error 400
 		return
 	}
@@ -194,7 +592,7 @@ func (o *OnuHandler) GetEmptyOnuID(w http.ResponseWriter, r *http.Request) {
 
 	// Validate ponIDInt value and return error 400 if ponIDInt is not between 1 and 8
 	if err != nil || ponIDInt < 1 || ponIDInt > 16 {
-		log.Error().Err(err).Msg("Invalid 'pon_id' parameter")
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'pon_id' parameter")
 		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'pon_id' parameter. It must be between 1 and 16")) // error 400
 		return
 	}
@@ -203,12 +601,12 @@ func (o *OnuHandler) GetEmptyOnuID(w http.ResponseWriter, r *http.Request) {
 	onuIDEmptyList, err := o.ponUsecase.GetEmptyOnuID(r.Context(), boardIDInt, ponIDInt)
 
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to get data from SNMP")
+		log.Error().Ctx(r.Context()).Err(err).Msg("Failed to get data from SNMP")
 		utils.ErrorInternalServerError(w, fmt.Errorf("cannot get data from snmp")) // error 500
 		return
 	}
 
-	log.Info().Msg("Successfully retrieved data from SNMP")
+	log.Info().Ctx(r.Context()).Msg("Successfully retrieved data from SNMP")
 
 	// Convert result to JSON format according to WebResponse structure
 	response := utils.WebResponse{
@@ -229,11 +627,11 @@ func (o *OnuHandler) GetOnuIDAndSerialNumber(w http.ResponseWriter, r *http.Requ
 
 	boardIDInt, err := strconv.Atoi(boardID) // convert string to int
 
-	log.Info().Msg("Received a request to GetOnuSerialNumber")
+	log.Info().Ctx(r.Context()).Msg("Received a request to GetOnuSerialNumber")
 
 	// Validate boardIDInt value and return error 400 if boardIDInt is not 1 or 2
 	if err != nil || (boardIDInt != 1 && boardIDInt != 2) {
-		log.Error().Err(err).Msg("Invalid 'board_id' parameter")
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'board_id' parameter")
 		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'board_id' parameter. It must be 1 or 2")) // error 400
 		return
 	}
@@ -242,21 +640,21 @@ func (o *OnuHandler) GetOnuIDAndSerialNumber(w http.ResponseWriter, r *http.Requ
 
 	// Validate ponIDInt value and return error 400 if ponIDInt is not between 1 and 8
 	if err != nil || ponIDInt < 1 || ponIDInt > 16 {
-		log.Error().Err(err).Msg("Invalid 'pon_id' parameter")
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'pon_id' parameter")
 		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'pon_id' parameter. It must be between 1 and 16")) // error 400
 		return
 	}
 
 	// Call usecase to get Serial Number from SNMP
-	onuSerialNumber, err := o.ponUsecase.GetOnuIDAndSerialNumber(boardIDInt, ponIDInt)
+	onuSerialNumber, err := o.ponUsecase.GetOnuIDAndSerialNumber(r.Context(), boardIDInt, ponIDInt)
 
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to get data from SNMP")
+		log.Error().Ctx(r.Context()).Err(err).Msg("Failed to get data from SNMP")
 		utils.ErrorInternalServerError(w, fmt.Errorf("cannot get data from snmp")) // error 500
 		return
 	}
 
-	log.Info().Msg("Successfully retrieved data from SNMP")
+	log.Info().Ctx(r.Context()).Msg("Successfully retrieved data from SNMP")
 
 	// Convert a result to JSON format according to WebResponse structure
 	response := utils.WebResponse{
@@ -276,11 +674,11 @@ func (o *OnuHandler) UpdateEmptyOnuID(w http.ResponseWriter, r *http.Request) {
 
 	boardIDInt, err := strconv.Atoi(boardID) // convert string to int
 
-	log.Info().Msg("Received a request to UpdateEmptyOnuID")
+	log.Info().Ctx(r.Context()).Msg("Received a request to UpdateEmptyOnuID")
 
 	// Validate boardIDInt value and return error 400 if boardIDInt is not 1 or 2
 	if err != nil || (boardIDInt != 1 && boardIDInt != 2) {
-		log.Error().Err(err).Msg("Invalid 'board_id' parameter")
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'board_id' parameter")
 		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'board_id' parameter. It must be 0 or 1")) // error 400
 		return
 	}
@@ -289,21 +687,25 @@ func (o *OnuHandler) UpdateEmptyOnuID(w http.ResponseWriter, r *http.Request) {
 
 	// Validate ponIDInt value and return error 400 if ponIDInt is not between 1 and 8
 	if err != nil || ponIDInt < 1 || ponIDInt > 16 {
-		log.Error().Err(err).Msg("Invalid 'pon_id' parameter")
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'pon_id' parameter")
 		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'pon_id' parameter. It must be between 1 and 16")) // error 400
 		return
 	}
 
 	// Call usecase to get data from SNMP
-	err = o.ponUsecase.UpdateEmptyOnuID(r.Context(), boardIDInt, ponIDInt)
+	before, after, err := o.ponUsecase.UpdateEmptyOnuID(r.Context(), boardIDInt, ponIDInt)
 
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to get data from SNMP")
+		log.Error().Ctx(r.Context()).Err(err).Msg("Failed to get data from SNMP")
+		audit.SetError(r.Context(), err)
 		utils.ErrorInternalServerError(w, fmt.Errorf("cannot get data from snmp")) // error 500
 		return
 	}
 
-	log.Info().Msg("Successfully retrieved data from SNMP")
+	// Record the replaced and the new empty ONU IDs in the audit log
+	audit.SetChange(r.Context(), before, after)
+
+	log.Info().Ctx(r.Context()).Msg("Successfully retrieved data from SNMP")
 
 	// Convert result to JSON format according to WebResponse structure
 	response := utils.WebResponse{
@@ -327,11 +729,11 @@ func (o *OnuHandler) GetByBoardIDAndPonIDWithPaginate(w http.ResponseWriter, r *
 
 	boardIDInt, err := strconv.Atoi(boardID) // convert string to int
 
-	log.Info().Msg("Received a request to GetByBoardIDAndPonIDWithPaginate")
+	log.Info().Ctx(r.Context()).Msg("Received a request to GetByBoardIDAndPonIDWithPaginate")
 
 	// Validate boardIDInt value and return error 400 if boardIDInt is not 1 or 2
 	if err != nil || (boardIDInt != 1 && boardIDInt != 2) {
-		log.Error().Err(err).Msg("Invalid 'board_id' parameter")
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'board_id' parameter")
 		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'board_id' parameter. It must be 1 or 2")) // error 400
 		return
 	}
@@ -340,13 +742,35 @@ func (o *OnuHandler) GetByBoardIDAndPonIDWithPaginate(w http.ResponseWriter, r *
 
 	// Validate ponIDInt value and return error 400 if ponIDInt is not between 1 and 8
 	if err != nil || ponIDInt < 1 || ponIDInt > 16 {
-		log.Error().Err(err).Msg("Invalid 'pon_id' parameter")
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'pon_id' parameter")
 		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'pon_id' parameter. It must be between 1 and 16")) // error 400
 		return
 	}
 
-	item, count := o.ponUsecase.GetByBoardIDAndPonIDWithPagination(boardIDInt, ponIDInt, pageIndex,
-		pageSize)
+	// Validate filter and sort query parameters and return error 400 if a query parameter is invalid
+	filter, err := utils.ParseOnuListFilter(r.URL.Query(), pagination.PageVar, pagination.PageSizeVar)
+	if err != nil {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid query parameter")
+		utils.ErrorBadRequest(w, err) // error 400
+		return
+	}
+
+	var item []model.ONUInfoPerBoard
+	var count int
+
+	if filter.IsEmpty() {
+		item, count = o.ponUsecase.GetByBoardIDAndPonIDWithPagination(r.Context(), boardIDInt, ponIDInt, pageIndex,
+			pageSize)
+	} else {
+		// Filter the cached ONU list, then paginate the filtered list
+		onuInfoList, err := o.ponUsecase.GetByBoardIDAndPonIDWithFilter(r.Context(), boardIDInt, ponIDInt, filter)
+		if err != nil {
+			log.Error().Ctx(r.Context()).Err(err).Msg("Failed to get data from SNMP")
+			utils.ErrorInternalServerError(w, fmt.Errorf("cannot get data from snmp")) // error 500
+			return
+		}
+		item, count = pagination.Slice(onuInfoList, pageIndex, pageSize), len(onuInfoList)
+	}
 
 	/*
 		Validate item value
@@ -354,7 +778,7 @@ func (o *OnuHandler) GetByBoardIDAndPonIDWithPaginate(w http.ResponseWriter, r *
 	*/
 
 	if len(item) == 0 {
-		log.Error().Msg("Data not found")
+		log.Error().Ctx(r.Context()).Msg("Data not found")
 		utils.ErrorNotFound(w, fmt.Errorf("data not found")) // error 404
 		return
 	}
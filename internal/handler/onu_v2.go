@@ -0,0 +1,331 @@
+package handler
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/usecase"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/utils"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/pkg/pagination"
+	"github.com/rs/zerolog/log"
+)
+
+// maxValidPower is the exclusive upper bound of a valid optical power reading in dBm,
+// anything above it is the sentinel value of an ONU that did not report
+const maxValidPower = 100
+
+// OnuHandlerV2 is a struct that represent the typed v2 handler
+type OnuHandlerV2 struct {
+	ponUsecase usecase.OnuUseCaseInterface
+}
+
+// NewOnuHandlerV2 will create an object that represent the typed v2 handler.
+func NewOnuHandlerV2(ponUsecase usecase.OnuUseCaseInterface) *OnuHandlerV2 {
+	return &OnuHandlerV2{ponUsecase: ponUsecase}
+}
+
+// GetByBoardIDAndPonID is a method to get typed onu info by board id and pon id
+// example: http://localhost:8080/api/v2/board/1/pon/1
+func (o *OnuHandlerV2) GetByBoardIDAndPonID(w http.ResponseWriter, r *http.Request) {
+
+	boardID := chi.URLParam(r, "board_id") // 1 or 2
+	ponID := chi.URLParam(r, "pon_id")     // 1 - 16
+
+	boardIDInt, err := strconv.Atoi(boardID) // convert string to int
+
+	log.Info().Ctx(r.Context()).Msg("Received a request to GetByBoardIDAndPonID v2")
+
+	// Validate boardIDInt value and return error 400 if boardIDInt is not 1 or 2
+	if err != nil || (boardIDInt != 1 && boardIDInt != 2) {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'board_id' parameter")
+		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'board_id' parameter. It must be 1 or 2")) // error 400
+		return
+	}
+
+	ponIDInt, err := strconv.Atoi(ponID) // convert string to int
+
+	// Validate ponIDInt value and return error 400 if ponIDInt is not between 1 and 16
+	if err != nil || ponIDInt < 1 || ponIDInt > 16 {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'pon_id' parameter")
+		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'pon_id' parameter. It must be between 1 and 16")) // error 400
+		return
+	}
+
+	// Validate filter and sort query parameters and return error 400 if a query parameter is invalid
+	filter, err := utils.ParseOnuListFilter(r.URL.Query())
+	if err != nil {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid query parameter")
+		utils.ErrorBadRequest(w, err) // error 400
+		return
+	}
+
+	// Call usecase to get data from SNMP or Redis and filter it
+	onuInfoList, err := o.ponUsecase.GetByBoardIDAndPonIDWithFilter(r.Context(), boardIDInt, ponIDInt, filter)
+	if err != nil {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Failed to get data from SNMP")
+		utils.ErrorInternalServerError(w, fmt.Errorf("cannot get data from snmp")) // error 500
+		return
+	}
+
+	if len(onuInfoList) == 0 {
+		log.Warn().Ctx(r.Context()).Msg("Data not found")
+		utils.ErrorNotFound(w, fmt.Errorf("data not found")) // error 404
+		return
+	}
+
+	// Convert result to JSON format according to WebResponse structure
+	response := utils.WebResponse{
+		Code:   http.StatusOK,                    // 200
+		Status: "OK",                             // "OK"
+		Data:   toONUInfoPerBoardV2(onuInfoList), // data
+	}
+
+	utils.SendJSONResponse(w, http.StatusOK, response) // 200
+}
+
+// GetAll is a method to get typed onu info of every pon on every board, with the same filters as the v1 list
+// example: http://localhost:8080/api/v2/onu?status=LOS,Offline&sort=rx_power:asc
+func (o *OnuHandlerV2) GetAll(w http.ResponseWriter, r *http.Request) {
+
+	log.Info().Ctx(r.Context()).Msg("Received a request to GetAll v2")
+
+	// Validate filter and sort query parameters and return error 400 if a query parameter is invalid
+	filter, err := utils.ParseOnuListFilter(r.URL.Query())
+	if err != nil {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid query parameter")
+		utils.ErrorBadRequest(w, err) // error 400
+		return
+	}
+
+	// Call usecase to get data from SNMP or Redis and filter it
+	onuInfoList, err := o.ponUsecase.GetAllWithFilter(r.Context(), filter)
+	if err != nil {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Failed to get data from SNMP")
+		utils.ErrorInternalServerError(w, fmt.Errorf("cannot get data from snmp")) // error 500
+		return
+	}
+
+	if len(onuInfoList) == 0 {
+		log.Warn().Ctx(r.Context()).Msg("Data not found")
+		utils.ErrorNotFound(w, fmt.Errorf("data not found")) // error 404
+		return
+	}
+
+	// Convert result to JSON format according to WebResponse structure
+	response := utils.WebResponse{
+		Code:   http.StatusOK,                    // 200
+		Status: "OK",                             // "OK"
+		Data:   toONUInfoPerBoardV2(onuInfoList), // data
+	}
+
+	utils.SendJSONResponse(w, http.StatusOK, response) // 200
+}
+
+// GetByBoardIDPonIDAndOnuID is a method to get typed onu info by board id, pon id, and onu id
+// example: http://localhost:8080/api/v2/board/1/pon/1/onu/1
+func (o *OnuHandlerV2) GetByBoardIDPonIDAndOnuID(w http.ResponseWriter, r *http.Request) {
+
+	boardID := chi.URLParam(r, "board_id") // 1 or 2
+	ponID := chi.URLParam(r, "pon_id")     // 1 - 16
+	onuID := chi.URLParam(r, "onu_id")     // 1 - 128
+
+	boardIDInt, err := strconv.Atoi(boardID) // convert string to int
+
+	log.Info().Ctx(r.Context()).Msg("Received a request to GetByBoardIDPonIDAndOnuID v2")
+
+	// Validate boardIDInt value and return error 400 if boardIDInt is not 1 or 2
+	if err != nil || (boardIDInt != 1 && boardIDInt != 2) {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'board_id' parameter")
+		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'board_id' parameter. It must be 1 or 2")) // error 400
+		return
+	}
+
+	ponIDInt, err := strconv.Atoi(ponID) // convert string to int
+
+	// Validate ponIDInt value and return error 400 if ponIDInt is not between 1 and 16
+	if err != nil || ponIDInt < 1 || ponIDInt > 16 {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'pon_id' parameter")
+		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'pon_id' parameter. It must be between 1 and 16")) // error 400
+		return
+	}
+
+	onuIDInt, err := strconv.Atoi(onuID) // convert string to int
+
+	// Validate onuIDInt value and return error 400 if onuIDInt is not between 1 and 128
+	if err != nil || onuIDInt < 1 || onuIDInt > 128 {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'onu_id' parameter")
+		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'onu_id' parameter. It must be between 1 and 128")) // error 400
+		return
+	}
+
+	// Call usecase to get data from SNMP
+	onuInfo, err := o.ponUsecase.GetByBoardIDPonIDAndOnuID(r.Context(), boardIDInt, ponIDInt, onuIDInt)
+	if err != nil {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Failed to get data from SNMP")
+		utils.ErrorInternalServerError(w, fmt.Errorf("cannot get data from snmp")) // error 500
+		return
+	}
+
+	if onuInfo.Board == 0 && onuInfo.PON == 0 && onuInfo.ID == 0 {
+		log.Error().Ctx(r.Context()).Msg("Data not found")
+		utils.ErrorNotFound(w, fmt.Errorf("data not found")) // error 404
+		return
+	}
+
+	// Convert a result to JSON format according to WebResponse structure
+	response := utils.WebResponse{
+		Code:   http.StatusOK,                                                           // 200
+		Status: "OK",                                                                    // "OK"
+		Data:   toONUCustomerInfoV2(onuInfo, o.ponUsecase.GetOltLocation(), time.Now()), // data
+	}
+
+	utils.SendJSONResponse(w, http.StatusOK, response) // 200
+}
+
+// GetByBoardIDAndPonIDWithPaginate is a method to get typed onu info by board id and pon id with pagination
+// example: http://localhost:8080/api/v2/paginate/board/1/pon/1?page=1&limit=10
+func (o *OnuHandlerV2) GetByBoardIDAndPonIDWithPaginate(w http.ResponseWriter, r *http.Request) {
+
+	boardID := chi.URLParam(r, "board_id") // 1 or 2
+	ponID := chi.URLParam(r, "pon_id")     // 1 - 16
+
+	// Get page and page size parameters from the request
+	pageIndex, pageSize := pagination.GetPaginationParametersFromRequest(r)
+
+	boardIDInt, err := strconv.Atoi(boardID) // convert string to int
+
+	log.Info().Ctx(r.Context()).Msg("Received a request to GetByBoardIDAndPonIDWithPaginate v2")
+
+	// Validate boardIDInt value and return error 400 if boardIDInt is not 1 or 2
+	if err != nil || (boardIDInt != 1 && boardIDInt != 2) {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'board_id' parameter")
+		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'board_id' parameter. It must be 1 or 2")) // error 400
+		return
+	}
+
+	ponIDInt, err := strconv.Atoi(ponID) // convert string to int
+
+	// Validate ponIDInt value and return error 400 if ponIDInt is not between 1 and 16
+	if err != nil || ponIDInt < 1 || ponIDInt > 16 {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid 'pon_id' parameter")
+		utils.ErrorBadRequest(w, fmt.Errorf("invalid 'pon_id' parameter. It must be between 1 and 16")) // error 400
+		return
+	}
+
+	// Validate filter and sort query parameters and return error 400 if a query parameter is invalid
+	filter, err := utils.ParseOnuListFilter(r.URL.Query(), pagination.PageVar, pagination.PageSizeVar)
+	if err != nil {
+		log.Error().Ctx(r.Context()).Err(err).Msg("Invalid query parameter")
+		utils.ErrorBadRequest(w, err) // error 400
+		return
+	}
+
+	var item []model.ONUInfoPerBoard
+	var count int
+
+	if filter.IsEmpty() {
+		item, count = o.ponUsecase.GetByBoardIDAndPonIDWithPagination(r.Context(), boardIDInt, ponIDInt, pageIndex, pageSize)
+	} else {
+		// Filter the cached ONU list, then paginate the filtered list
+		onuInfoList, err := o.ponUsecase.GetByBoardIDAndPonIDWithFilter(r.Context(), boardIDInt, ponIDInt, filter)
+		if err != nil {
+			log.Error().Ctx(r.Context()).Err(err).Msg("Failed to get data from SNMP")
+			utils.ErrorInternalServerError(w, fmt.Errorf("cannot get data from snmp")) // error 500
+			return
+		}
+		item, count = pagination.Slice(onuInfoList, pageIndex, pageSize), len(onuInfoList)
+	}
+
+	if len(item) == 0 {
+		log.Error().Ctx(r.Context()).Msg("Data not found")
+		utils.ErrorNotFound(w, fmt.Errorf("data not found")) // error 404
+		return
+	}
+
+	pages := pagination.New(pageIndex, pageSize, count)
+
+	// Convert result to JSON format according to Pages structure
+	responsePagination := pagination.Pages{
+		Code:      http.StatusOK,             // 200
+		Status:    "OK",                      // "OK"
+		Page:      pages.Page,                // page
+		PageSize:  pages.PageSize,            // page size
+		PageCount: pages.PageCount,           // page count
+		TotalRows: pages.TotalRows,           // total rows
+		Data:      toONUInfoPerBoardV2(item), // data
+	}
+
+	utils.SendJSONResponse(w, http.StatusOK, responsePagination) // 200
+}
+
+// toONUInfoPerBoardV2 converts the v1 ONU list to the typed v2 ONU list
+func toONUInfoPerBoardV2(onuInfoList []model.ONUInfoPerBoard) []model.ONUInfoPerBoardV2 {
+	result := make([]model.ONUInfoPerBoardV2, 0, len(onuInfoList))
+	for _, onuInfo := range onuInfoList {
+		result = append(result, model.ONUInfoPerBoardV2{
+			Board:        onuInfo.Board,
+			PON:          onuInfo.PON,
+			ID:           onuInfo.ID,
+			Name:         onuInfo.Name,
+			OnuType:      utils.StringOrNil(onuInfo.OnuType),
+			SerialNumber: utils.StringOrNil(onuInfo.SerialNumber),
+			RXPower:      utils.ParseFloatOrNil(onuInfo.RXPower, maxValidPower),
+			OltRXPower:   utils.ParseFloatOrNil(onuInfo.OltRXPower, maxValidPower),
+			Status:       onuInfo.Status,
+		})
+	}
+	return result
+}
+
+// toONUCustomerInfoV2 converts the v1 ONU detail to the typed v2 ONU detail.
+// Uptime is only reported while the ONU is Online, durations are computed from the zoned timestamps
+func toONUCustomerInfoV2(onuInfo model.ONUCustomerInfo, oltLocation *time.Location, now time.Time) model.ONUCustomerInfoV2 {
+	result := model.ONUCustomerInfoV2{
+		Board:                  onuInfo.Board,
+		PON:                    onuInfo.PON,
+		ID:                     onuInfo.ID,
+		Name:                   onuInfo.Name,
+		Description:            utils.StringOrNil(onuInfo.Description),
+		OnuType:                utils.StringOrNil(onuInfo.OnuType),
+		SerialNumber:           utils.StringOrNil(onuInfo.SerialNumber),
+		RXPower:                utils.ParseFloatOrNil(onuInfo.RXPower, maxValidPower),
+		TXPower:                utils.ParseFloatOrNil(onuInfo.TXPower, maxValidPower),
+		OltRXPower:             utils.ParseFloatOrNil(onuInfo.OltRXPower, maxValidPower),
+		Temperature:            utils.ParseFloatOrNil(onuInfo.Temperature, math.Inf(1)),
+		Voltage:                utils.ParseFloatOrNil(onuInfo.Voltage, math.Inf(1)),
+		BiasCurrent:            utils.ParseFloatOrNil(onuInfo.BiasCurrent, math.Inf(1)),
+		Status:                 onuInfo.Status,
+		IPAddress:              utils.StringOrNil(onuInfo.IPAddress),
+		LastOnline:             utils.ParseDateTimeInLocation(onuInfo.LastOnline, oltLocation),
+		LastOffline:            utils.ParseDateTimeInLocation(onuInfo.LastOffline, oltLocation),
+		LastOfflineReason:      utils.StringOrNil(onuInfo.LastOfflineReason),
+		GponOpticalDistance:    utils.ParseIntOrNil(onuInfo.GponOpticalDistance),
+		HardwareVersion:        utils.StringOrNil(onuInfo.HardwareVersion),
+		SoftwareVersionActive:  utils.StringOrNil(onuInfo.SoftwareVersionActive),
+		SoftwareVersionStandby: utils.StringOrNil(onuInfo.SoftwareVersionStandby),
+		EquipmentID:            utils.StringOrNil(onuInfo.EquipmentID),
+	}
+
+	// The counters are null when they could not be read, e.g. from an offline ONU or when not selected
+	if onuInfo.TrafficRead {
+		traffic := onuInfo.Traffic
+		result.Traffic = &traffic
+	}
+
+	if result.LastOnline != nil && onuInfo.Status == "Online" {
+		uptime := int64(now.Sub(*result.LastOnline).Seconds())
+		result.UptimeSeconds = &uptime
+	}
+
+	if result.LastOnline != nil && result.LastOffline != nil && !result.LastOnline.Before(*result.LastOffline) {
+		downtime := int64(result.LastOnline.Sub(*result.LastOffline).Seconds())
+		result.LastDownTimeSeconds = &downtime
+	}
+
+	return result
+}
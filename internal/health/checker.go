@@ -0,0 +1,135 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gosnmp/gosnmp"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/repository"
+)
+
+// sysUpTimeOID is the SNMPv2-MIB sysUpTime of the OLT, in hundredths of a second
+const sysUpTimeOID = ".1.3.6.1.2.1.1.3.0"
+
+// CheckFunc checks a dependency, it returns a short detail of a healthy dependency or the error
+type CheckFunc func(ctx context.Context) (detail string, err error)
+
+// Check is a named dependency check
+type Check struct {
+	Name  string
+	Check CheckFunc
+}
+
+// Checker runs the dependency checks of the readiness probe. The result is reused for cacheTTL, so frequent probes
+// of Kubernetes and the load balancer do not flood the OLT with SNMP requests
+type Checker struct {
+	checks   []Check
+	timeout  time.Duration
+	cacheTTL time.Duration
+
+	mu        sync.Mutex
+	statuses  []model.DependencyStatus
+	checkedAt time.Time
+}
+
+// NewChecker creates a new Checker, every check must answer within timeout.
+func NewChecker(timeout, cacheTTL time.Duration, checks ...Check) *Checker {
+	statuses := make([]model.DependencyStatus, len(checks))
+	for i, check := range checks {
+		statuses[i] = model.DependencyStatus{Name: check.Name, Status: model.HealthStatusDown}
+	}
+	return &Checker{checks: checks, timeout: timeout, cacheTTL: cacheTTL, statuses: statuses}
+}
+
+// Readiness runs every check at once, or returns the last result when it is not older than the cache TTL
+func (c *Checker) Readiness(ctx context.Context) model.Readiness {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.checkedAt.IsZero() || time.Since(c.checkedAt) >= c.cacheTTL {
+		c.run(ctx)
+	}
+
+	readiness := model.Readiness{
+		Status:       model.HealthStatusUp,
+		Dependencies: make([]model.DependencyStatus, len(c.statuses)),
+	}
+	copy(readiness.Dependencies, c.statuses)
+	for _, status := range c.statuses {
+		if status.Status != model.HealthStatusUp {
+			readiness.Status = model.HealthStatusDown
+		}
+	}
+
+	return readiness
+}
+
+// run runs every check at once and updates the statuses
+func (c *Checker) run(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for i, check := range c.checks {
+		wg.Add(1)
+		go func(status *model.DependencyStatus, check Check) {
+			defer wg.Done()
+
+			start := time.Now()
+			detail, err := check.Check(ctx)
+			now := time.Now()
+
+			status.LatencyMs = float64(now.Sub(start).Microseconds()) / 1000
+			status.CheckedAt = now
+			status.Detail = detail
+			if err != nil {
+				status.Status = model.HealthStatusDown
+				status.LastError = err.Error()
+				status.LastErrorAt = &now
+				return
+			}
+			status.Status = model.HealthStatusUp
+			status.LastSuccessAt = &now
+		}(&c.statuses[i], check)
+	}
+	wg.Wait()
+
+	c.checkedAt = time.Now()
+}
+
+// SnmpUptimeCheck checks an OLT by reading its sysUpTime, the detail is the uptime of the OLT
+func SnmpUptimeCheck(snmpRepo repository.SnmpRepositoryInterface) CheckFunc {
+	return func(ctx context.Context) (string, error) {
+		type result struct {
+			packet *gosnmp.SnmpPacket
+			err    error
+		}
+
+		// The SNMP repository has its own timeout, do not wait for it longer than the check
+		resultCh := make(chan result, 1)
+		go func() {
+			packet, err := snmpRepo.Get(ctx, []string{sysUpTimeOID})
+			resultCh <- result{packet, err}
+		}()
+
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("sysUpTime: %w", ctx.Err())
+		case res := <-resultCh:
+			if res.err != nil {
+				return "", fmt.Errorf("sysUpTime: %w", res.err)
+			}
+			if res.packet == nil || len(res.packet.Variables) == 0 ||
+				res.packet.Variables[0].Type != gosnmp.TimeTicks {
+				return "", fmt.Errorf("sysUpTime: unexpected response")
+			}
+
+			ticks := gosnmp.ToBigInt(res.packet.Variables[0].Value).Int64()
+			uptime := time.Duration(ticks) * 10 * time.Millisecond
+			return "uptime " + uptime.Truncate(time.Second).String(), nil
+		}
+	}
+}
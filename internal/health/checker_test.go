@@ -0,0 +1,109 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gosnmp/gosnmp"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeSnmpRepo answers the sysUpTime Get, any other method panics on the nil interface
+type fakeSnmpRepo struct {
+	repository.SnmpRepositoryInterface
+	packet *gosnmp.SnmpPacket
+	err    error
+	delay  time.Duration
+}
+
+func (f *fakeSnmpRepo) Get(_ context.Context, oids []string) (*gosnmp.SnmpPacket, error) {
+	time.Sleep(f.delay)
+	return f.packet, f.err
+}
+
+func TestCheckerReadiness(t *testing.T) {
+	var redisDown atomic.Bool
+	var calls atomic.Int32
+
+	checker := NewChecker(time.Second, 0,
+		Check{Name: "olt", Check: func(context.Context) (string, error) {
+			calls.Add(1)
+			return "uptime 1h0m0s", nil
+		}},
+		Check{Name: "redis", Check: func(context.Context) (string, error) {
+			if redisDown.Load() {
+				return "", errors.New("dial tcp 127.0.0.1:6379: connect: connection refused")
+			}
+			return "", nil
+		}},
+	)
+
+	readiness := checker.Readiness(context.Background())
+	assert.Equal(t, model.HealthStatusUp, readiness.Status)
+	assert.Len(t, readiness.Dependencies, 2)
+	assert.Equal(t, "olt", readiness.Dependencies[0].Name)
+	assert.Equal(t, "uptime 1h0m0s", readiness.Dependencies[0].Detail)
+	assert.NotNil(t, readiness.Dependencies[1].LastSuccessAt)
+	assert.Empty(t, readiness.Dependencies[1].LastError)
+
+	redisDown.Store(true)
+	readiness = checker.Readiness(context.Background())
+	assert.Equal(t, model.HealthStatusDown, readiness.Status)
+	assert.Equal(t, model.HealthStatusUp, readiness.Dependencies[0].Status)
+	assert.Equal(t, model.HealthStatusDown, readiness.Dependencies[1].Status)
+	assert.Contains(t, readiness.Dependencies[1].LastError, "connection refused")
+
+	// The last error is kept once the dependency recovers
+	redisDown.Store(false)
+	readiness = checker.Readiness(context.Background())
+	assert.Equal(t, model.HealthStatusUp, readiness.Status)
+	assert.Contains(t, readiness.Dependencies[1].LastError, "connection refused")
+	assert.NotNil(t, readiness.Dependencies[1].LastErrorAt)
+
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestCheckerCache(t *testing.T) {
+	var calls atomic.Int32
+	checker := NewChecker(time.Second, time.Hour, Check{Name: "olt", Check: func(context.Context) (string, error) {
+		calls.Add(1)
+		return "", nil
+	}})
+
+	checker.Readiness(context.Background())
+	checker.Readiness(context.Background())
+
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestSnmpUptimeCheck(t *testing.T) {
+	up := &fakeSnmpRepo{packet: &gosnmp.SnmpPacket{Variables: []gosnmp.SnmpPDU{
+		{Name: sysUpTimeOID, Type: gosnmp.TimeTicks, Value: uint32(864012345)},
+	}}}
+	detail, err := SnmpUptimeCheck(up)(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "uptime 2400h2m3s", detail)
+
+	// A wrong community times out
+	down := &fakeSnmpRepo{err: errors.New("request timeout (after 1 retries)")}
+	_, err = SnmpUptimeCheck(down)(context.Background())
+	assert.ErrorContains(t, err, "request timeout")
+
+	// The check does not wait for the SNMP timeout
+	slow := &fakeSnmpRepo{delay: time.Second}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = SnmpUptimeCheck(slow)(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	noSuchObject := &fakeSnmpRepo{packet: &gosnmp.SnmpPacket{Variables: []gosnmp.SnmpPDU{
+		{Name: sysUpTimeOID, Type: gosnmp.NoSuchObject},
+	}}}
+	_, err = SnmpUptimeCheck(noSuchObject)(context.Background())
+	assert.Error(t, err)
+}
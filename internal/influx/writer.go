@@ -0,0 +1,256 @@
+package influx
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/rs/zerolog/log"
+)
+
+// Defaults of the writer, used for zero values of config.InfluxConfig.
+const (
+	defaultBatchSize  = 5000
+	defaultMaxRetries = 3
+	defaultTimeout    = 10 * time.Second
+	defaultRetryWait  = time.Second
+	maxRetryWait      = 30 * time.Second
+)
+
+var (
+	measurementEscaper = strings.NewReplacer(`,`, `\,`, ` `, `\ `, "\n", `\n`)
+	keyEscaper         = strings.NewReplacer(`,`, `\,`, `=`, `\=`, ` `, `\ `, "\n", `\n`)
+)
+
+// Writer writes measurements to the write endpoint of an InfluxDB v2 server in line protocol with second
+// precision. Every measurement gets the olt tag, so several OLTs can share a bucket.
+type Writer struct {
+	client     *http.Client
+	writeURL   string
+	token      string
+	olt        string
+	batchSize  int
+	maxRetries int
+	retryWait  time.Duration
+	gzip       bool
+}
+
+// NewWriter creates a new Writer for the server, organization and bucket of cfg.
+func NewWriter(cfg config.InfluxConfig, olt string) (*Writer, error) {
+	if cfg.URL == "" || cfg.Org == "" || cfg.Bucket == "" {
+		return nil, errors.New("influx url, org and bucket are required")
+	}
+
+	writeURL, err := url.JoinPath(cfg.URL, "/api/v2/write")
+	if err != nil {
+		return nil, fmt.Errorf("invalid influx url: %w", err)
+	}
+	query := url.Values{}
+	query.Set("org", cfg.Org)
+	query.Set("bucket", cfg.Bucket)
+	query.Set("precision", "s")
+
+	w := &Writer{
+		client:     &http.Client{Timeout: time.Duration(cfg.TimeoutSeconds) * time.Second},
+		writeURL:   writeURL + "?" + query.Encode(),
+		token:      cfg.Token,
+		olt:        olt,
+		batchSize:  cfg.BatchSize,
+		maxRetries: cfg.MaxRetries,
+		retryWait:  defaultRetryWait,
+		gzip:       cfg.Gzip,
+	}
+
+	if w.client.Timeout <= 0 {
+		w.client.Timeout = defaultTimeout
+	}
+	if w.batchSize <= 0 {
+		w.batchSize = defaultBatchSize
+	}
+	if w.maxRetries <= 0 {
+		w.maxRetries = defaultMaxRetries
+	}
+
+	return w, nil
+}
+
+// Name implements exporter.Sink.
+func (w *Writer) Name() string {
+	return "influxdb"
+}
+
+// Write implements exporter.Sink. The measurements are sent in batches of at most the configured number of
+// lines, a batch that fails is retried and the remaining batches are still sent. The first error is returned.
+func (w *Writer) Write(ctx context.Context, points []model.Measurement) error {
+	var firstErr error
+	lines := 0
+	var batch bytes.Buffer
+
+	flush := func() {
+		if lines == 0 {
+			return
+		}
+		if err := w.send(ctx, batch.Bytes()); err != nil && firstErr == nil {
+			firstErr = err
+		}
+		batch.Reset()
+		lines = 0
+	}
+
+	for _, point := range points {
+		if !w.appendLine(&batch, point) {
+			continue
+		}
+		lines++
+		if lines >= w.batchSize {
+			flush()
+		}
+	}
+	flush()
+
+	return firstErr
+}
+
+// appendLine appends the line protocol of point to buf. Fields that are NaN or infinite can not be written,
+// a point without any other field is left out and false is returned.
+func (w *Writer) appendLine(buf *bytes.Buffer, point model.Measurement) bool {
+	fieldKeys := make([]string, 0, len(point.Fields))
+	for key, value := range point.Fields {
+		if !math.IsNaN(value) && !math.IsInf(value, 0) {
+			fieldKeys = append(fieldKeys, key)
+		}
+	}
+	if len(fieldKeys) == 0 {
+		return false
+	}
+	sort.Strings(fieldKeys)
+
+	tags := make(map[string]string, len(point.Tags)+1)
+	for key, value := range point.Tags {
+		tags[key] = value
+	}
+	if w.olt != "" {
+		tags["olt"] = w.olt
+	}
+	tagKeys := make([]string, 0, len(tags))
+	for key, value := range tags {
+		// Empty tag values are not allowed by the line protocol
+		if value != "" {
+			tagKeys = append(tagKeys, key)
+		}
+	}
+	sort.Strings(tagKeys) // InfluxDB writes faster with the tags in key order
+
+	buf.WriteString(measurementEscaper.Replace(point.Name))
+	for _, key := range tagKeys {
+		buf.WriteByte(',')
+		buf.WriteString(keyEscaper.Replace(key))
+		buf.WriteByte('=')
+		buf.WriteString(keyEscaper.Replace(tags[key]))
+	}
+	for i, key := range fieldKeys {
+		if i == 0 {
+			buf.WriteByte(' ')
+		} else {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(keyEscaper.Replace(key))
+		buf.WriteByte('=')
+		buf.WriteString(strconv.FormatFloat(point.Fields[key], 'f', -1, 64))
+	}
+	buf.WriteByte(' ')
+	buf.WriteString(strconv.FormatInt(point.Time.Unix(), 10))
+	buf.WriteByte('\n')
+	return true
+}
+
+// send posts a batch of lines. Network errors, 429 and 5xx responses are retried with an exponential
+// backoff, or after the Retry-After of the server, other responses are returned as error right away.
+func (w *Writer) send(ctx context.Context, lines []byte) error {
+	body := lines
+	if w.gzip {
+		var compressed bytes.Buffer
+		gz := gzip.NewWriter(&compressed)
+		if _, err := gz.Write(lines); err != nil {
+			return fmt.Errorf("failed to compress influx batch: %w", err)
+		}
+		if err := gz.Close(); err != nil {
+			return fmt.Errorf("failed to compress influx batch: %w", err)
+		}
+		body = compressed.Bytes()
+	}
+
+	wait := w.retryWait
+	for attempt := 0; ; attempt++ {
+		retryAfter, err := w.post(ctx, body)
+		if err == nil {
+			return nil
+		}
+		if retryAfter < 0 || attempt >= w.maxRetries || ctx.Err() != nil {
+			return err
+		}
+
+		if retryAfter > 0 {
+			wait = min(retryAfter, maxRetryWait)
+		}
+		log.Warn().Err(err).Int("attempt", attempt+1).Dur("wait", wait).Msg("Retrying influx write")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		wait = min(wait*2, maxRetryWait)
+	}
+}
+
+// post sends a single write request. On failure it returns how long the server asked to wait before a retry,
+// 0 when it did not say, or -1 when the request must not be retried.
+func (w *Writer) post(ctx context.Context, body []byte) (time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.writeURL, bytes.NewReader(body))
+	if err != nil {
+		return -1, fmt.Errorf("failed to create influx write request: %w", err)
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	if w.token != "" {
+		req.Header.Set("Authorization", "Token "+w.token)
+	}
+	if w.gzip {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("influx write request failed: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return 0, nil
+	}
+
+	message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	err = fmt.Errorf("influx write failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode < 500 {
+		return -1, err
+	}
+	if seconds, parseErr := strconv.Atoi(resp.Header.Get("Retry-After")); parseErr == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second, err
+	}
+	return 0, err
+}
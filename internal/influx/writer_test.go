@@ -0,0 +1,170 @@
+package influx
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeInflux records the write requests and answers them with the queued status codes, 204 once they run out
+type fakeInflux struct {
+	mu       sync.Mutex
+	statuses []int
+	requests []*http.Request
+	bodies   []string
+}
+
+func (f *fakeInflux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body = gz
+	}
+	lines, _ := io.ReadAll(body)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, r)
+	f.bodies = append(f.bodies, string(lines))
+
+	status := http.StatusNoContent
+	if len(f.statuses) > 0 {
+		status, f.statuses = f.statuses[0], f.statuses[1:]
+	}
+	if status == http.StatusTooManyRequests {
+		w.Header().Set("Retry-After", "1")
+	}
+	w.WriteHeader(status)
+}
+
+func newTestWriter(t *testing.T, fake *fakeInflux, cfg config.InfluxConfig) *Writer {
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	cfg.URL, cfg.Org, cfg.Bucket = server.URL, "noc", "olt"
+	w, err := NewWriter(cfg, "10.0.0.1:161")
+	require.NoError(t, err)
+	w.retryWait = time.Millisecond
+	return w
+}
+
+var collectedAt = time.Date(2025, 8, 11, 10, 0, 0, 0, time.UTC)
+
+func onuPoint(onuID string) model.Measurement {
+	return model.Measurement{
+		Name:   "zte_onu",
+		Tags:   map[string]string{"board": "1", "pon": "8", "onu_id": onuID},
+		Fields: map[string]float64{"rx_power_dbm": -20.71, "status": 1},
+		Time:   collectedAt,
+	}
+}
+
+func TestWriterWritesLineProtocol(t *testing.T) {
+	fake := &fakeInflux{}
+	w := newTestWriter(t, fake, config.InfluxConfig{Token: "secret", Gzip: true})
+
+	require.NoError(t, w.Write(context.Background(), []model.Measurement{onuPoint("3")}))
+
+	require.Len(t, fake.requests, 1)
+	r := fake.requests[0]
+	assert.Equal(t, "/api/v2/write", r.URL.Path)
+	assert.Equal(t, "olt", r.URL.Query().Get("bucket"))
+	assert.Equal(t, "noc", r.URL.Query().Get("org"))
+	assert.Equal(t, "s", r.URL.Query().Get("precision"))
+	assert.Equal(t, "Token secret", r.Header.Get("Authorization"))
+	assert.Equal(t, "gzip", r.Header.Get("Content-Encoding"))
+	assert.Equal(t, "zte_onu,board=1,olt=10.0.0.1:161,onu_id=3,pon=8 rx_power_dbm=-20.71,status=1 1754906400\n", fake.bodies[0])
+}
+
+func TestWriterEscapesAndSkipsInvalidFields(t *testing.T) {
+	fake := &fakeInflux{}
+	w := newTestWriter(t, fake, config.InfluxConfig{})
+
+	points := []model.Measurement{
+		{
+			Name:   "zte onu,uni",
+			Tags:   map[string]string{"port name": "eth=1,a", "empty": ""},
+			Fields: map[string]float64{"speed mbps": 1000, "broken": math.NaN()},
+			Time:   collectedAt,
+		},
+		{Name: "zte_onu", Fields: map[string]float64{"rx_power_dbm": math.Inf(-1)}, Time: collectedAt},
+	}
+	require.NoError(t, w.Write(context.Background(), points))
+
+	require.Len(t, fake.bodies, 1)
+	assert.Equal(t, `zte\ onu\,uni,olt=10.0.0.1:161,port\ name=eth\=1\,a speed\ mbps=1000 1754906400`+"\n", fake.bodies[0])
+	assert.Empty(t, fake.requests[0].Header.Get("Content-Encoding"))
+}
+
+func TestWriterBatches(t *testing.T) {
+	fake := &fakeInflux{}
+	w := newTestWriter(t, fake, config.InfluxConfig{BatchSize: 2})
+
+	points := []model.Measurement{onuPoint("1"), onuPoint("2"), onuPoint("3"), onuPoint("4"), onuPoint("5")}
+	require.NoError(t, w.Write(context.Background(), points))
+
+	require.Len(t, fake.bodies, 3)
+	assert.Equal(t, 2, strings.Count(fake.bodies[0], "\n"))
+	assert.Equal(t, 2, strings.Count(fake.bodies[1], "\n"))
+	assert.Equal(t, 1, strings.Count(fake.bodies[2], "\n"))
+}
+
+func TestWriterRetries(t *testing.T) {
+	fake := &fakeInflux{statuses: []int{http.StatusServiceUnavailable, http.StatusInternalServerError}}
+	w := newTestWriter(t, fake, config.InfluxConfig{MaxRetries: 3})
+
+	require.NoError(t, w.Write(context.Background(), []model.Measurement{onuPoint("1")}))
+	assert.Len(t, fake.requests, 3)
+}
+
+func TestWriterGivesUpAfterMaxRetries(t *testing.T) {
+	fake := &fakeInflux{statuses: []int{503, 503, 503, 503}}
+	w := newTestWriter(t, fake, config.InfluxConfig{MaxRetries: 2})
+
+	err := w.Write(context.Background(), []model.Measurement{onuPoint("1")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 503")
+	assert.Len(t, fake.requests, 3)
+}
+
+func TestWriterDoesNotRetryClientErrors(t *testing.T) {
+	fake := &fakeInflux{statuses: []int{http.StatusBadRequest}}
+	w := newTestWriter(t, fake, config.InfluxConfig{BatchSize: 1})
+
+	// The failed batch is reported, the next one is still written
+	err := w.Write(context.Background(), []model.Measurement{onuPoint("1"), onuPoint("2")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 400")
+	assert.Len(t, fake.requests, 2)
+}
+
+func TestWriterHonorsRetryAfter(t *testing.T) {
+	fake := &fakeInflux{statuses: []int{http.StatusTooManyRequests}}
+	w := newTestWriter(t, fake, config.InfluxConfig{})
+
+	started := time.Now()
+	require.NoError(t, w.Write(context.Background(), []model.Measurement{onuPoint("1")}))
+	assert.Len(t, fake.requests, 2)
+	assert.GreaterOrEqual(t, time.Since(started), time.Second)
+}
+
+func TestNewWriterRequiresBucket(t *testing.T) {
+	_, err := NewWriter(config.InfluxConfig{URL: "http://localhost:8086", Org: "noc"}, "")
+	assert.Error(t, err)
+}
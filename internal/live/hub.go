@@ -0,0 +1,194 @@
+package live
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/tracing"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/usecase"
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Defaults of the hub, used for zero values of config.LiveConfig
+const (
+	defaultPollInterval = 2 * time.Second
+	defaultMaxSessions  = 10
+)
+
+// ErrTooManySessions is returned when the OLT already has the maximum number of live sessions
+var ErrTooManySessions = errors.New("too many live sessions")
+
+// liveFields are the only ONU detail fields polled by a live session, read with a single SNMP Get
+var liveFields = []string{"rx_power", "tx_power", "status"}
+
+// onuKey identifies an ONU of the OLT
+type onuKey struct {
+	board int
+	pon   int
+	onu   int
+}
+
+// meter polls a single ONU and sends every reading to its viewers. Its context carries the span of the meter and
+// is cancelled when the meter stops.
+type meter struct {
+	viewers map[chan model.OnuLiveReading]struct{}
+	last    *model.OnuLiveReading
+	ctx     context.Context
+	stop    context.CancelFunc
+}
+
+// Hub shares the polling of an ONU between every live session watching it and caps the number of live sessions,
+// so a crowd of technicians can not flood the OLT SNMP agent
+type Hub struct {
+	onuUsecase  usecase.OnuUseCaseInterface
+	interval    time.Duration
+	maxSessions int
+
+	mu       sync.Mutex
+	sessions int
+	meters   map[onuKey]*meter
+}
+
+// NewHub creates a new Hub polling every 1 or 2 seconds, with at most the maximum number of live sessions of cfg.
+// The zero values of cfg use the defaults.
+func NewHub(onuUsecase usecase.OnuUseCaseInterface, cfg config.LiveConfig) *Hub {
+	interval := defaultPollInterval
+	switch cfg.PollIntervalSeconds {
+	case 0:
+	case 1, 2:
+		interval = time.Duration(cfg.PollIntervalSeconds) * time.Second
+	default:
+		log.Warn().Msgf("Invalid live poll interval of %d seconds, it must be 1 or 2, using %s",
+			cfg.PollIntervalSeconds, defaultPollInterval)
+	}
+	maxSessions := cfg.MaxSessions
+	if maxSessions <= 0 {
+		maxSessions = defaultMaxSessions
+	}
+
+	return &Hub{
+		onuUsecase:  onuUsecase,
+		interval:    interval,
+		maxSessions: maxSessions,
+		meters:      make(map[onuKey]*meter),
+	}
+}
+
+// Join starts a live session of an ONU. The readings channel receives the latest reading, a slow viewer skips
+// readings instead of delaying the others. leave must be called when the session ends, it closes the channel and
+// stops the polling once the last viewer of the ONU is gone.
+func (h *Hub) Join(boardID, ponID, onuID int) (readings <-chan model.OnuLiveReading, leave func(), err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.sessions >= h.maxSessions {
+		return nil, nil, ErrTooManySessions
+	}
+	h.sessions++
+
+	key := onuKey{board: boardID, pon: ponID, onu: onuID}
+	m, ok := h.meters[key]
+	if !ok {
+		m = &meter{viewers: make(map[chan model.OnuLiveReading]struct{})}
+		m.ctx, m.stop = context.WithCancel(context.Background())
+		h.meters[key] = m
+		go h.run(key, m)
+	}
+
+	viewer := make(chan model.OnuLiveReading, 1)
+	m.viewers[viewer] = struct{}{}
+	if m.last != nil {
+		viewer <- *m.last
+	}
+
+	var once sync.Once
+	leave = func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+
+			h.sessions--
+			delete(m.viewers, viewer)
+			close(viewer)
+			if len(m.viewers) == 0 {
+				m.stop()
+				delete(h.meters, key)
+			}
+		})
+	}
+
+	return viewer, leave, nil
+}
+
+// Sessions returns the number of live sessions
+func (h *Hub) Sessions() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.sessions
+}
+
+// run polls the ONU until the meter is stopped, every poll is a child of the span of the meter
+func (h *Hub) run(key onuKey, m *meter) {
+	ctx, span := tracing.Start(m.ctx, "Live meter", attribute.Int("olt.board", key.board),
+		attribute.Int("olt.pon", key.pon), attribute.Int("olt.onu_id", key.onu))
+	defer tracing.End(span, nil)
+
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	for {
+		reading := h.read(ctx, key)
+
+		h.mu.Lock()
+		if ctx.Err() != nil {
+			h.mu.Unlock()
+			return
+		}
+
+		m.last = &reading
+		for viewer := range m.viewers {
+			// Replace an unread reading with the latest one
+			select {
+			case <-viewer:
+			default:
+			}
+			viewer <- reading
+		}
+		h.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// read polls the rx power, tx power and status of the ONU
+func (h *Hub) read(ctx context.Context, key onuKey) model.OnuLiveReading {
+	reading := model.OnuLiveReading{Board: key.board, PON: key.pon, ID: key.onu, Time: time.Now()}
+
+	onuInfo, err := h.onuUsecase.GetByBoardIDPonIDAndOnuIDWithFields(ctx, key.board, key.pon, key.onu, liveFields)
+	switch {
+	case ctx.Err() != nil:
+		// The meter stopped during the poll, nobody reads this reading
+	case err != nil:
+		log.Error().Ctx(ctx).Err(err).Int("board", key.board).Int("pon", key.pon).Int("onu_id", key.onu).
+			Msg("Failed to get live reading from SNMP")
+		reading.Error = "cannot get data from snmp"
+	case onuInfo.Board == 0 && onuInfo.PON == 0 && onuInfo.ID == 0:
+		// The ONU may not be registered yet while it is being installed, keep polling
+		reading.Error = "data not found"
+	default:
+		reading.RXPower = onuInfo.RXPower
+		reading.TXPower = onuInfo.TXPower
+		reading.Status = onuInfo.Status
+	}
+
+	return reading
+}
@@ -0,0 +1,119 @@
+package live
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeOnuUsecase counts the live polls, any other method panics on the nil interface
+type fakeOnuUsecase struct {
+	usecase.OnuUseCaseInterface
+	polls   atomic.Int32
+	lastCtx atomic.Value // context of the last poll
+}
+
+func (f *fakeOnuUsecase) GetByBoardIDPonIDAndOnuIDWithFields(ctx context.Context, boardID, ponID, onuID int, _ []string) (
+	model.ONUCustomerInfo, error,
+) {
+	f.polls.Add(1)
+	f.lastCtx.Store(ctx)
+	if onuID == 128 {
+		return model.ONUCustomerInfo{}, nil
+	}
+	return model.ONUCustomerInfo{Board: boardID, PON: ponID, ID: onuID, RXPower: "-20.71", Status: "Online"}, nil
+}
+
+func TestHubSharesPolling(t *testing.T) {
+	fake := &fakeOnuUsecase{}
+	hub := NewHub(fake, config.LiveConfig{})
+	hub.interval = 20 * time.Millisecond
+
+	first, leaveFirst, err := hub.Join(1, 2, 3)
+	assert.NoError(t, err)
+	reading := <-first
+	assert.Equal(t, "-20.71", reading.RXPower)
+
+	// A second viewer of the same ONU gets the last reading at once and shares the polling
+	second, leaveSecond, err := hub.Join(1, 2, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, "Online", (<-second).Status)
+	assert.Equal(t, 2, hub.Sessions())
+
+	time.Sleep(100 * time.Millisecond)
+	assert.LessOrEqual(t, fake.polls.Load(), int32(7))
+
+	leaveFirst()
+	leaveSecond()
+	leaveSecond() // leave is idempotent
+	assert.Equal(t, 0, hub.Sessions())
+
+	// Polling stops with the last viewer
+	time.Sleep(50 * time.Millisecond)
+	polls := fake.polls.Load()
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, polls, fake.polls.Load())
+}
+
+func TestHubSessionCap(t *testing.T) {
+	hub := NewHub(&fakeOnuUsecase{}, config.LiveConfig{PollIntervalSeconds: 1, MaxSessions: 2})
+
+	_, leave, err := hub.Join(1, 1, 1)
+	assert.NoError(t, err)
+	_, _, err = hub.Join(1, 1, 2)
+	assert.NoError(t, err)
+
+	_, _, err = hub.Join(1, 1, 3)
+	assert.ErrorIs(t, err, ErrTooManySessions)
+
+	leave()
+	_, _, err = hub.Join(1, 1, 3)
+	assert.NoError(t, err)
+}
+
+func TestHubOnuNotFound(t *testing.T) {
+	hub := NewHub(&fakeOnuUsecase{}, config.LiveConfig{PollIntervalSeconds: 1, MaxSessions: 1})
+
+	readings, leave, err := hub.Join(1, 1, 128)
+	assert.NoError(t, err)
+	defer leave()
+
+	reading := <-readings
+	assert.Equal(t, "data not found", reading.Error)
+	assert.Equal(t, 128, reading.ID)
+}
+
+func TestNewHubDefaults(t *testing.T) {
+	hub := NewHub(&fakeOnuUsecase{}, config.LiveConfig{})
+	assert.Equal(t, defaultPollInterval, hub.interval)
+	assert.Equal(t, defaultMaxSessions, hub.maxSessions)
+
+	hub = NewHub(&fakeOnuUsecase{}, config.LiveConfig{PollIntervalSeconds: 1, MaxSessions: 3})
+	assert.Equal(t, time.Second, hub.interval)
+	assert.Equal(t, 3, hub.maxSessions)
+
+	// Intervals other than 1 or 2 seconds would flood the OLT or not be live anymore
+	hub = NewHub(&fakeOnuUsecase{}, config.LiveConfig{PollIntervalSeconds: 10})
+	assert.Equal(t, defaultPollInterval, hub.interval)
+}
+
+func TestHubCancelsMeterContext(t *testing.T) {
+	fake := &fakeOnuUsecase{}
+	hub := NewHub(fake, config.LiveConfig{})
+
+	readings, leave, err := hub.Join(1, 2, 3)
+	assert.NoError(t, err)
+	<-readings
+
+	ctx := fake.lastCtx.Load().(context.Context)
+	assert.NoError(t, ctx.Err())
+
+	leave()
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+}
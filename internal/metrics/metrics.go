@@ -0,0 +1,141 @@
+// Package metrics holds the Prometheus metrics the service reports about itself, they tell whether a slow request
+// is waiting on the OLT, on Redis or on the service.
+package metrics
+
+import (
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Operation label values of the SNMP metrics.
+const (
+	SnmpOperationGet  = "get"
+	SnmpOperationWalk = "walk"
+)
+
+// Result label values of RedisCacheRequests.
+const (
+	CacheResultHit   = "hit"
+	CacheResultMiss  = "miss"
+	CacheResultError = "error"
+)
+
+var (
+	// SnmpRequestDuration shows how long the OLT takes to answer SNMP requests.
+	SnmpRequestDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "snmp_request_duration_seconds",
+			Help:    "The duration of SNMP requests to the OLT in seconds.",
+			Buckets: []float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		},
+		[]string{"operation", "oid_family"},
+	)
+
+	// SnmpRequestErrors counts the failed SNMP requests, timeouts included.
+	SnmpRequestErrors = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "snmp_request_errors_total",
+			Help: "The number of SNMP requests to the OLT that failed, timeouts included.",
+		},
+		[]string{"olt", "operation"},
+	)
+
+	// SnmpRequestTimeouts counts the SNMP requests that got no answer from the OLT.
+	SnmpRequestTimeouts = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "snmp_request_timeouts_total",
+			Help: "The number of SNMP requests to the OLT that timed out.",
+		},
+		[]string{"olt", "operation"},
+	)
+
+	// SingleflightCalls counts the usecase calls that went through singleflight, shared is true when the
+	// result was shared with a concurrent caller.
+	SingleflightCalls = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "singleflight_calls_total",
+			Help: "The number of usecase calls deduplicated with singleflight, by whether the result was shared.",
+		},
+		[]string{"call", "shared"},
+	)
+
+	// RedisRequestDuration shows how long Redis takes to answer the cache requests.
+	RedisRequestDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "redis_request_duration_seconds",
+			Help:    "The duration of Redis cache requests in seconds.",
+			Buckets: []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 1},
+		},
+		[]string{"method"},
+	)
+
+	// RedisCacheRequests counts the cache requests by result, reads are a hit or a miss, writes only count errors.
+	RedisCacheRequests = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "redis_cache_requests_total",
+			Help: "The number of Redis cache requests by method and result (hit, miss or error).",
+		},
+		[]string{"method", "result"},
+	)
+
+	// HTTPRequestDuration shows how long the API takes to answer, by chi route pattern.
+	HTTPRequestDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "The duration of HTTP requests in seconds by method, route pattern and status code.",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method", "route", "code"},
+	)
+)
+
+// oidFamilies maps the ZTE MIB tables the service reads to a short family name, the longest prefix wins.
+var oidFamilies = []struct {
+	prefix string
+	family string
+}{
+	{".1.3.6.1.4.1.3902.1082.500.10.2.3.3", "onu_config"},
+	{".1.3.6.1.4.1.3902.1082.500.10.2.3.8", "onu_state"},
+	{".1.3.6.1.4.1.3902.1082.500.10.2.3.10", "onu_distance"},
+	{".1.3.6.1.4.1.3902.1082.500.20.2.2.2", "onu_rx_power"},
+	{".1.3.6.1.4.1.3902.1082.500.1.2.4.2", "olt_rx_power"},
+	{".1.3.6.1.4.1.3902.1012.3.50.11.2", "onu_version"},
+	{".1.3.6.1.4.1.3902.1012.3.50.12.1", "onu_transceiver"},
+	{".1.3.6.1.4.1.3902.1012.3.50.14.1", "onu_uni"},
+	{".1.3.6.1.4.1.3902.1012.3.50.16.1", "onu_ip"},
+	{".1.3.6.1.4.1.3902.1012.3.50.20.1", "onu_traffic"},
+	{".1.3.6.1.2.1.1", "system"},
+	{".1.3.6.1.2.1.25.1", "host"},
+}
+
+// OIDFamily returns the family of an OID for the oid_family label, unknown OIDs are "other".
+func OIDFamily(oid string) string {
+	if !strings.HasPrefix(oid, ".") {
+		oid = "." + oid
+	}
+
+	family, longest := "other", 0
+	for _, f := range oidFamilies {
+		if len(f.prefix) > longest && (oid == f.prefix || strings.HasPrefix(oid, f.prefix+".")) {
+			family, longest = f.family, len(f.prefix)
+		}
+	}
+	return family
+}
+
+// OIDsFamily returns the family shared by all OIDs of a request, or "mixed" when they differ.
+func OIDsFamily(oids []string) string {
+	if len(oids) == 0 {
+		return "other"
+	}
+
+	family := OIDFamily(oids[0])
+	for _, oid := range oids[1:] {
+		if OIDFamily(oid) != family {
+			return "mixed"
+		}
+	}
+	return family
+}
@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOIDFamily(t *testing.T) {
+	tests := []struct {
+		oid    string
+		family string
+	}{
+		{".1.3.6.1.4.1.3902.1082.500.10.2.3.3.1.2.285278465.4", "onu_config"},
+		{".1.3.6.1.4.1.3902.1082.500.10.2.3.8.1.4.285278465.4", "onu_state"},
+		{".1.3.6.1.4.1.3902.1082.500.10.2.3.10.1.2.285278465.4", "onu_distance"},
+		{".1.3.6.1.4.1.3902.1082.500.20.2.2.2.1.10.285278465.4.1", "onu_rx_power"},
+		{"1.3.6.1.4.1.3902.1012.3.50.20.1.1.2.268501248.4", "onu_traffic"},
+		{".1.3.6.1.2.1.1.3.0", "system"},
+		{".1.3.6.1.2.1.25.1.2.0", "host"},
+		{".1.3.6.1.4.1.3902.1082.500.10.2.3.30", "other"}, // must not match the .3.3 prefix
+		{".1.3.6.1.4.1.9", "other"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.family, OIDFamily(tt.oid), tt.oid)
+	}
+}
+
+func TestOIDsFamily(t *testing.T) {
+	assert.Equal(t, "other", OIDsFamily(nil))
+	assert.Equal(t, "onu_uni", OIDsFamily([]string{
+		".1.3.6.1.4.1.3902.1012.3.50.14.1.1.5.268501248.4.1",
+		".1.3.6.1.4.1.3902.1012.3.50.14.1.1.7.268501248.4.1",
+	}))
+	assert.Equal(t, "mixed", OIDsFamily([]string{
+		".1.3.6.1.4.1.3902.1082.500.10.2.3.3.1.2.285278465.4",
+		".1.3.6.1.4.1.3902.1012.3.50.12.1.1.14.268501248.4",
+	}))
+}
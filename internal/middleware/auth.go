@@ -0,0 +1,197 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/repository"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/utils"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// APIKeyHeader is the request header of the API key, "Authorization: Bearer <key>" is accepted too
+	APIKeyHeader = "X-API-Key"
+	// APIKeyQueryParam is the query parameter of the API key, for the browser EventSource and WebSocket clients
+	// that can not set a header
+	APIKeyQueryParam = "api_key"
+)
+
+// roleRanks ranks the roles, a role can access every route of a lower ranked role
+var roleRanks = map[string]int{
+	model.RoleViewer:      1,
+	model.RoleOperator:    2,
+	model.RoleProvisioner: 3,
+}
+
+// apiKeyContextKey is the context key of the authenticated API key
+type apiKeyContextKey struct{}
+
+// Authenticator authenticates the API requests with API keys. The keys are looked up by their SHA-256 hash in the
+// configuration first, then in Redis when a repository is set
+type Authenticator struct {
+	enabled             bool
+	apiKeys             map[string]model.APIKey // by key hash
+	apiKeyRepo          repository.APIKeyRepositoryInterface
+	metricsUsername     string
+	metricsPasswordHash string
+}
+
+// NewAuthenticator will create an authenticator from the auth configuration, apiKeyRepo may be nil
+func NewAuthenticator(cfg config.AuthConfig, apiKeyRepo repository.APIKeyRepositoryInterface) *Authenticator {
+	apiKeys := make(map[string]model.APIKey, len(cfg.APIKeys))
+	for _, apiKey := range cfg.APIKeys {
+		if _, ok := roleRanks[apiKey.Role]; !ok {
+			log.Warn().Str("name", apiKey.Name).Msgf("Ignoring API key with unknown role '%s'", apiKey.Role)
+			continue
+		}
+		apiKeys[strings.ToLower(apiKey.KeyHash)] = model.APIKey{Name: apiKey.Name, Role: apiKey.Role}
+	}
+
+	return &Authenticator{
+		enabled:             cfg.Enabled,
+		apiKeys:             apiKeys,
+		apiKeyRepo:          apiKeyRepo,
+		metricsUsername:     cfg.MetricsUsername,
+		metricsPasswordHash: cfg.MetricsPasswordHash,
+	}
+}
+
+// HashAPIKey returns the SHA-256 hex hash of an API key, the form in which the keys are stored
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// APIKeyFromContext returns the API key that authenticated the request, false when authentication is disabled
+func APIKeyFromContext(ctx context.Context) (model.APIKey, bool) {
+	apiKey, ok := ctx.Value(apiKeyContextKey{}).(model.APIKey)
+	return apiKey, ok
+}
+
+// RequireRole is a middleware function that only lets through the requests with an API key of the role or of a
+// higher ranked role. It returns 401 when the key is missing or unknown and 403 when its role is too low.
+// The api_key query parameter is removed from the request, so the handlers do not see it as a filter.
+func (a *Authenticator) RequireRole(role string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			key := extractAPIKey(r)
+
+			if !a.enabled {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// An outer route group may have authenticated the request already
+			apiKey, ok := APIKeyFromContext(r.Context())
+			if !ok {
+				if key == "" {
+					w.Header().Set("WWW-Authenticate", `Bearer realm="api"`)
+					utils.ErrorUnauthorized(w, fmt.Errorf("missing api key")) // error 401
+					return
+				}
+
+				found, err := a.lookup(r.Context(), key)
+				if err != nil {
+					log.Error().Err(err).Msg("Failed to look up api key")
+					utils.ErrorInternalServerError(w, fmt.Errorf("cannot verify api key")) // error 500
+					return
+				}
+				if found == nil {
+					log.Warn().Str("remote_addr", r.RemoteAddr).Msg("Invalid api key")
+					w.Header().Set("WWW-Authenticate", `Bearer realm="api", error="invalid_token"`)
+					utils.ErrorUnauthorized(w, fmt.Errorf("invalid api key")) // error 401
+					return
+				}
+				apiKey = *found
+			}
+
+			if roleRanks[apiKey.Role] < roleRanks[role] {
+				log.Warn().Str("api_key", apiKey.Name).Msgf("Api key role '%s' is not allowed, '%s' required",
+					apiKey.Role, role)
+				utils.ErrorForbidden(w, fmt.Errorf("this endpoint requires the '%s' role", role)) // error 403
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), apiKeyContextKey{}, apiKey)))
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+// MetricsAuth is a middleware function that protects the metrics endpoint with basic authentication when a
+// metrics username and password hash are configured, independently of the API keys
+func (a *Authenticator) MetricsAuth() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if a.metricsUsername == "" || a.metricsPasswordHash == "" {
+			return next
+		}
+
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			username, password, ok := r.BasicAuth()
+			if !ok ||
+				subtle.ConstantTimeCompare([]byte(username), []byte(a.metricsUsername)) != 1 ||
+				bcrypt.CompareHashAndPassword([]byte(a.metricsPasswordHash), []byte(password)) != nil {
+				w.Header().Set("WWW-Authenticate", `Basic realm="metrics"`)
+				utils.ErrorUnauthorized(w, fmt.Errorf("invalid metrics credentials")) // error 401
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+// lookup returns the API key of a key from the configuration or Redis, nil when it is unknown
+func (a *Authenticator) lookup(ctx context.Context, key string) (*model.APIKey, error) {
+	keyHash := HashAPIKey(key)
+
+	if apiKey, ok := a.apiKeys[keyHash]; ok {
+		return &apiKey, nil
+	}
+	if a.apiKeyRepo == nil {
+		return nil, nil
+	}
+
+	apiKey, err := a.apiKeyRepo.GetAPIKey(ctx, keyHash)
+	if err != nil || apiKey == nil {
+		return nil, err
+	}
+	if _, ok := roleRanks[apiKey.Role]; !ok {
+		log.Warn().Str("name", apiKey.Name).Msgf("Ignoring API key with unknown role '%s'", apiKey.Role)
+		return nil, nil
+	}
+
+	return apiKey, nil
+}
+
+// extractAPIKey returns the API key of the request from the X-API-Key header, the Authorization bearer token or
+// the api_key query parameter, and removes the query parameter from the request
+func extractAPIKey(r *http.Request) string {
+	query := r.URL.Query()
+	queryKey := query.Get(APIKeyQueryParam)
+	if query.Has(APIKeyQueryParam) {
+		query.Del(APIKeyQueryParam)
+		r.URL.RawQuery = query.Encode()
+	}
+
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		return key
+	}
+	if scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " "); ok && strings.EqualFold(scheme, "Bearer") {
+		return strings.TrimSpace(token)
+	}
+
+	return queryKey
+}
@@ -0,0 +1,184 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// fakeAPIKeyRepo is an API key repository backed by a map
+type fakeAPIKeyRepo struct {
+	apiKeys map[string]model.APIKey
+	err     error
+}
+
+func (f *fakeAPIKeyRepo) GetAPIKey(_ context.Context, keyHash string) (*model.APIKey, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	apiKey, ok := f.apiKeys[keyHash]
+	if !ok {
+		return nil, nil
+	}
+	return &apiKey, nil
+}
+
+// okHandler answers 200 with the name of the authenticated API key
+var okHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	apiKey, _ := APIKeyFromContext(r.Context())
+	_, _ = w.Write([]byte(apiKey.Name))
+})
+
+func newTestAuthenticator(repo *fakeAPIKeyRepo) *Authenticator {
+	return NewAuthenticator(config.AuthConfig{
+		Enabled: true,
+		APIKeys: []config.APIKeyConfig{
+			{Name: "dashboard", KeyHash: HashAPIKey("viewer-key"), Role: model.RoleViewer},
+			{Name: "noc", KeyHash: HashAPIKey("operator-key"), Role: model.RoleOperator},
+			{Name: "typo", KeyHash: HashAPIKey("typo-key"), Role: "admin"},
+		},
+	}, repo)
+}
+
+func TestRequireRole(t *testing.T) {
+	repo := &fakeAPIKeyRepo{apiKeys: map[string]model.APIKey{
+		HashAPIKey("redis-key"): {Name: "provisioning", Role: model.RoleProvisioner},
+	}}
+	auth := newTestAuthenticator(repo)
+
+	tests := []struct {
+		name   string
+		role   string
+		header map[string]string
+		target string
+		code   int
+		body   string
+	}{
+		{"missing key", model.RoleViewer, nil, "/", http.StatusUnauthorized, ""},
+		{"unknown key", model.RoleViewer, map[string]string{APIKeyHeader: "nope"}, "/", http.StatusUnauthorized, ""},
+		{"unknown role in config", model.RoleViewer, map[string]string{APIKeyHeader: "typo-key"}, "/",
+			http.StatusUnauthorized, ""},
+		{"viewer key header", model.RoleViewer, map[string]string{APIKeyHeader: "viewer-key"}, "/",
+			http.StatusOK, "dashboard"},
+		{"bearer token", model.RoleViewer, map[string]string{"Authorization": "Bearer viewer-key"}, "/",
+			http.StatusOK, "dashboard"},
+		{"query parameter", model.RoleViewer, nil, "/?api_key=viewer-key", http.StatusOK, "dashboard"},
+		{"viewer key on operator route", model.RoleOperator, map[string]string{APIKeyHeader: "viewer-key"}, "/",
+			http.StatusForbidden, ""},
+		{"operator key on viewer route", model.RoleViewer, map[string]string{APIKeyHeader: "operator-key"}, "/",
+			http.StatusOK, "noc"},
+		{"redis key", model.RoleProvisioner, map[string]string{APIKeyHeader: "redis-key"}, "/",
+			http.StatusOK, "provisioning"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			for key, value := range tt.header {
+				request.Header.Set(key, value)
+			}
+			recorder := httptest.NewRecorder()
+
+			auth.RequireRole(tt.role)(okHandler).ServeHTTP(recorder, request)
+
+			assert.Equal(t, tt.code, recorder.Code)
+			if tt.code == http.StatusOK {
+				assert.Equal(t, tt.body, recorder.Body.String())
+			}
+			if tt.code == http.StatusUnauthorized {
+				assert.NotEmpty(t, recorder.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
+
+func TestRequireRoleRemovesQueryParameter(t *testing.T) {
+	auth := newTestAuthenticator(&fakeAPIKeyRepo{})
+
+	var query string
+	handler := auth.RequireRole(model.RoleViewer)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/?api_key=viewer-key&status=LOS", nil))
+
+	assert.Equal(t, "status=LOS", query)
+}
+
+func TestRequireRoleNestedGroups(t *testing.T) {
+	repo := &fakeAPIKeyRepo{apiKeys: map[string]model.APIKey{}}
+	auth := newTestAuthenticator(repo)
+
+	// The inner group reuses the key authenticated by the outer group, the query parameter is already removed
+	handler := auth.RequireRole(model.RoleViewer)(auth.RequireRole(model.RoleOperator)(okHandler))
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/?api_key=operator-key", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "noc", recorder.Body.String())
+}
+
+func TestRequireRoleRepositoryError(t *testing.T) {
+	auth := newTestAuthenticator(&fakeAPIKeyRepo{err: errors.New("connection refused")})
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Header.Set(APIKeyHeader, "redis-key")
+	auth.RequireRole(model.RoleViewer)(okHandler).ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+}
+
+func TestRequireRoleDisabled(t *testing.T) {
+	auth := NewAuthenticator(config.AuthConfig{}, nil)
+
+	recorder := httptest.NewRecorder()
+	auth.RequireRole(model.RoleProvisioner)(okHandler).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestMetricsAuth(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	assert.NoError(t, err)
+
+	auth := NewAuthenticator(config.AuthConfig{MetricsUsername: "prometheus", MetricsPasswordHash: string(hash)}, nil)
+
+	tests := []struct {
+		name     string
+		username string
+		password string
+		code     int
+	}{
+		{"no credentials", "", "", http.StatusUnauthorized},
+		{"wrong username", "grafana", "secret", http.StatusUnauthorized},
+		{"wrong password", "prometheus", "guess", http.StatusUnauthorized},
+		{"valid", "prometheus", "secret", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+			if tt.username != "" {
+				request.SetBasicAuth(tt.username, tt.password)
+			}
+			recorder := httptest.NewRecorder()
+
+			auth.MetricsAuth()(okHandler).ServeHTTP(recorder, request)
+
+			assert.Equal(t, tt.code, recorder.Code)
+		})
+	}
+
+	// Without credentials configured the metrics stay open
+	recorder := httptest.NewRecorder()
+	NewAuthenticator(config.AuthConfig{}, nil).MetricsAuth()(okHandler).
+		ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
@@ -2,19 +2,45 @@ package middleware
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/go-chi/cors"
 )
 
 // CorsMiddleware is a middleware function that sets up CORS (Cross-Origin Resource Sharing)
-// for the HTTP server. It allows requests from any origin and supports common HTTP methods
-func CorsMiddleware() func(next http.Handler) http.Handler {
+// for the HTTP server. It only allows requests from the allowed origins, an empty list allows
+// no cross-origin request at all, and supports common HTTP methods
+func CorsMiddleware(allowedOrigins []string) func(next http.Handler) http.Handler {
 	return cors.Handler(cors.Options{
-		AllowedOrigins:   []string{"https://*", "http://*"},
+		// AllowedOrigins is not used, because the cors package allows any origin when it is empty
+		AllowOriginFunc: func(_ *http.Request, origin string) bool {
+			return OriginAllowed(allowedOrigins, origin)
+		},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", APIKeyHeader},
 		ExposedHeaders:   []string{"Link"},
 		AllowCredentials: false,
 		MaxAge:           300, // Maximum value not ignored by any of major browsers
 	})
 }
+
+// OriginAllowed returns true when the origin matches one of the allowed origins. An allowed origin may contain
+// one "*" wildcard, e.g. https://*.example.com, and "*" alone allows any origin
+func OriginAllowed(allowedOrigins []string, origin string) bool {
+	origin = strings.ToLower(origin)
+
+	for _, allowed := range allowedOrigins {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		if allowed == "*" || allowed == origin {
+			return true
+		}
+
+		prefix, suffix, ok := strings.Cut(allowed, "*")
+		if ok && len(origin) >= len(prefix)+len(suffix) &&
+			strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOriginAllowed(t *testing.T) {
+	allowed := []string{"https://noc.example.com", "https://*.isp.example", " http://localhost:3000 "}
+
+	tests := []struct {
+		origin string
+		want   bool
+	}{
+		{"https://noc.example.com", true},
+		{"HTTPS://NOC.EXAMPLE.COM", true},
+		{"http://noc.example.com", false},
+		{"https://grafana.isp.example", true},
+		{"https://isp.example", false},
+		{"https://evil.example", false},
+		{"http://localhost:3000", true},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.origin, func(t *testing.T) {
+			assert.Equal(t, tt.want, OriginAllowed(allowed, tt.origin))
+		})
+	}
+
+	assert.False(t, OriginAllowed(nil, "https://noc.example.com"))
+	assert.True(t, OriginAllowed([]string{"*"}, "https://noc.example.com"))
+}
+
+func TestCorsMiddleware(t *testing.T) {
+	handler := CorsMiddleware([]string{"https://noc.example.com"})(okHandler)
+
+	for origin, want := range map[string]string{
+		"https://noc.example.com": "https://noc.example.com",
+		"https://evil.example":    "",
+	} {
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.Header.Set("Origin", origin)
+		recorder := httptest.NewRecorder()
+
+		handler.ServeHTTP(recorder, request)
+
+		assert.Equal(t, want, recorder.Header().Get("Access-Control-Allow-Origin"), origin)
+	}
+}
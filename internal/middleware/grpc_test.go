@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeServerStream is a server stream that only carries a context
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (f *fakeServerStream) Context() context.Context {
+	return f.ctx
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	repo := &fakeAPIKeyRepo{apiKeys: map[string]model.APIKey{
+		HashAPIKey("redis-key"): {Name: "provisioning", Role: model.RoleProvisioner},
+	}}
+	auth := newTestAuthenticator(repo)
+
+	tests := []struct {
+		name     string
+		role     string
+		metadata []string
+		wantCode codes.Code
+		wantKey  string
+	}{
+		{name: "missing key", role: model.RoleViewer, wantCode: codes.Unauthenticated},
+		{name: "invalid key", role: model.RoleViewer, metadata: []string{"x-api-key", "wrong"},
+			wantCode: codes.Unauthenticated},
+		{name: "unknown role", role: model.RoleViewer, metadata: []string{"x-api-key", "typo-key"},
+			wantCode: codes.Unauthenticated},
+		{name: "api key metadata", role: model.RoleViewer, metadata: []string{"x-api-key", "viewer-key"},
+			wantCode: codes.OK, wantKey: "dashboard"},
+		{name: "bearer token", role: model.RoleViewer, metadata: []string{"authorization", "Bearer operator-key"},
+			wantCode: codes.OK, wantKey: "noc"},
+		{name: "redis key", role: model.RoleOperator, metadata: []string{"x-api-key", "redis-key"},
+			wantCode: codes.OK, wantKey: "provisioning"},
+		{name: "role too low", role: model.RoleOperator, metadata: []string{"x-api-key", "viewer-key"},
+			wantCode: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tt.metadata...))
+
+			var gotKey string
+			handler := func(ctx context.Context, _ any) (any, error) {
+				apiKey, _ := APIKeyFromContext(ctx)
+				gotKey = apiKey.Name
+				return "ok", nil
+			}
+
+			_, err := auth.UnaryServerInterceptor(tt.role)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test"}, handler)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantKey, gotKey)
+		})
+	}
+}
+
+func TestUnaryServerInterceptorRepositoryError(t *testing.T) {
+	auth := newTestAuthenticator(&fakeAPIKeyRepo{err: errors.New("connection refused")})
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-api-key", "redis-key"))
+
+	_, err := auth.UnaryServerInterceptor(model.RoleViewer)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test"},
+		func(context.Context, any) (any, error) { return nil, nil })
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	auth := newTestAuthenticator(&fakeAPIKeyRepo{})
+	info := &grpc.StreamServerInfo{FullMethod: "/test", IsServerStream: true}
+
+	var gotKey string
+	handler := func(_ any, stream grpc.ServerStream) error {
+		apiKey, _ := APIKeyFromContext(stream.Context())
+		gotKey = apiKey.Name
+		return nil
+	}
+
+	err := auth.StreamServerInterceptor(model.RoleViewer)(nil, &fakeServerStream{ctx: context.Background()}, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-api-key", "viewer-key"))
+	err = auth.StreamServerInterceptor(model.RoleViewer)(nil, &fakeServerStream{ctx: ctx}, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "dashboard", gotKey)
+}
+
+func TestServerInterceptorDisabled(t *testing.T) {
+	auth := NewAuthenticator(config.AuthConfig{}, nil)
+
+	_, err := auth.UnaryServerInterceptor(model.RoleProvisioner)(context.Background(), nil,
+		&grpc.UnaryServerInfo{FullMethod: "/test"}, func(context.Context, any) (any, error) { return nil, nil })
+	assert.NoError(t, err)
+}
@@ -18,18 +18,20 @@ func Logger(logger zerolog.Logger) func(next http.Handler) http.Handler {
 		fn := func(w http.ResponseWriter, r *http.Request) {
 			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
 			startTime := time.Now()
+			ctx := r.Context() // Carries the request span, its trace ID is added to the log lines
 
 			defer func() {
 				endTime := time.Now()                 // End time
 				elapsedTime := endTime.Sub(startTime) // Request time
 
 				if r := recover(); r != nil && r != http.ErrAbortHandler {
-					logger.Error().Interface("recover", r).Bytes("stack", debug.Stack()).Msg("incoming_request_panic")
+					logger.Error().Ctx(ctx).Interface("recover", r).Bytes("stack", debug.Stack()).Msg("incoming_request_panic")
 					ww.WriteHeader(http.StatusInternalServerError)
 				}
 
-				logger.Info().Fields(map[string]interface{}{
+				logger.Info().Ctx(ctx).Fields(map[string]interface{}{
 					"time":         startTime.Format(time.RFC3339), // Format using RFC3339
+					"request_id":   middleware.GetReqID(r.Context()),
 					"remote_addr":  r.RemoteAddr,
 					"path":         r.URL.Path,
 					"proto":        r.Proto,
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/metrics"
+)
+
+// unmatchedRoute is the route label of requests that did not match any route, it keeps scanners from creating
+// a series for every path they try
+const unmatchedRoute = "unmatched"
+
+// Metrics records the duration of every request by method, chi route pattern and status code. The pattern is
+// only complete once chi has routed the request, so it is read after the handler returns.
+func Metrics(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		start := time.Now()
+
+		next.ServeHTTP(ww, r)
+
+		route := unmatchedRoute
+		if routeCtx := chi.RouteContext(r.Context()); routeCtx != nil && routeCtx.RoutePattern() != "" {
+			route = routeCtx.RoutePattern()
+		}
+
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+
+		metrics.HTTPRequestDuration.WithLabelValues(r.Method, route, strconv.Itoa(status)).
+			Observe(time.Since(start).Seconds())
+	}
+
+	return http.HandlerFunc(fn)
+}
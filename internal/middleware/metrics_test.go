@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsLabelsRoutePattern(t *testing.T) {
+	router := chi.NewRouter()
+	router.Use(Metrics)
+
+	board := chi.NewRouter()
+	board.Get("/{board_id}/pon/{pon_id}", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	router.Mount("/api/v1/board", board)
+
+	before := testutil.CollectAndCount(metrics.HTTPRequestDuration)
+	for _, path := range []string{"/api/v1/board/1/pon/1", "/api/v1/board/2/pon/7", "/wp-login.php"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	// Both board requests share a series with the route pattern, the unknown path is not a series of its own
+	assert.Equal(t, before+2, testutil.CollectAndCount(metrics.HTTPRequestDuration))
+	assert.Equal(t, uint64(2), histogramCount(t, "GET", "/api/v1/board/{board_id}/pon/{pon_id}", "418"))
+	assert.Equal(t, uint64(1), histogramCount(t, "GET", unmatchedRoute, "404"))
+}
+
+// histogramCount returns the number of observations of an HTTPRequestDuration series
+func histogramCount(t *testing.T, labels ...string) uint64 {
+	t.Helper()
+	metric, ok := metrics.HTTPRequestDuration.WithLabelValues(labels...).(prometheus.Metric)
+	assert.True(t, ok)
+	var m dto.Metric
+	assert.NoError(t, metric.Write(&m))
+	return m.GetHistogram().GetSampleCount()
+}
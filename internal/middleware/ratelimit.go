@@ -0,0 +1,263 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/repository"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/utils"
+	"github.com/rs/zerolog/log"
+)
+
+// Route classes of the rate limiter, priced by the load they put on the OLT SNMP agent
+const (
+	RouteClassCached = "cached" // served from the Redis cache or memory
+	RouteClassSNMP   = "snmp"   // reads the OLT on every request
+	RouteClassExport = "export" // reads the detail of many ONUs
+	RouteClassUpdate = "update" // walks a PON again to refresh the Redis cache
+)
+
+const (
+	defaultRateLimitCapacity        = 60
+	defaultRateLimitRefillPerSecond = 1
+
+	// bucketCleanupInterval is how often the idle buckets of the in-memory store are removed
+	bucketCleanupInterval = time.Minute
+)
+
+// defaultRouteClassCosts are the costs of the route classes missing from the configuration
+var defaultRouteClassCosts = map[string]float64{
+	RouteClassCached: 1,
+	RouteClassSNMP:   5,
+	RouteClassExport: 30,
+	RouteClassUpdate: 30,
+}
+
+// RateLimiter limits the requests of every client, identified by its API key or else its IP address, with a token
+// bucket. The buckets are kept in memory, or in Redis when a repository is set so the limits hold across replicas
+type RateLimiter struct {
+	enabled         bool
+	capacity        float64
+	refillPerSecond float64
+	costs           map[string]float64
+	trustedProxies  []*net.IPNet
+	store           repository.RateLimitRepositoryInterface
+}
+
+// NewRateLimiter will create a rate limiter from the rate limit configuration, a nil store keeps the buckets in memory
+func NewRateLimiter(cfg config.RateLimitConfig, store repository.RateLimitRepositoryInterface) *RateLimiter {
+	capacity := cfg.Capacity
+	if capacity <= 0 {
+		capacity = defaultRateLimitCapacity
+	}
+	refillPerSecond := cfg.RefillPerSecond
+	if refillPerSecond <= 0 {
+		refillPerSecond = defaultRateLimitRefillPerSecond
+	}
+
+	costs := make(map[string]float64, len(defaultRouteClassCosts))
+	for class, cost := range defaultRouteClassCosts {
+		costs[class] = cost
+	}
+	for class, cost := range cfg.Costs {
+		if _, ok := defaultRouteClassCosts[class]; !ok {
+			log.Warn().Msgf("Ignoring rate limit cost of unknown route class '%s'", class)
+			continue
+		}
+		// A cost above the capacity could never be paid
+		costs[class] = math.Min(math.Max(cost, 0), capacity)
+	}
+
+	trustedProxies := make([]*net.IPNet, 0, len(cfg.TrustedProxies))
+	for _, proxy := range cfg.TrustedProxies {
+		proxyNet, err := parseIPNet(strings.TrimSpace(proxy))
+		if err != nil {
+			log.Warn().Err(err).Msgf("Ignoring invalid trusted proxy '%s'", proxy)
+			continue
+		}
+		trustedProxies = append(trustedProxies, proxyNet)
+	}
+
+	if store == nil {
+		store = newMemoryTokenBuckets(time.Now)
+	}
+
+	return &RateLimiter{
+		enabled:         cfg.Enabled,
+		capacity:        capacity,
+		refillPerSecond: refillPerSecond,
+		costs:           costs,
+		trustedProxies:  trustedProxies,
+		store:           store,
+	}
+}
+
+// Limit is a middleware function that takes the cost of the route class from the bucket of the client, and returns
+// 429 with a Retry-After header when the bucket does not hold enough tokens. It must run after RequireRole, so the
+// client is identified by its API key. When the Redis store fails the request is let through.
+func (l *RateLimiter) Limit(class string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !l.enabled {
+			return next
+		}
+
+		cost := l.costs[class]
+
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			client := l.client(r)
+
+			allowed, remaining, err := l.store.TakeTokens(r.Context(), client, cost, l.capacity, l.refillPerSecond)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to check rate limit, letting the request through")
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Set("X-RateLimit-Limit", strconv.FormatFloat(l.capacity, 'f', -1, 64))
+			w.Header().Set("X-RateLimit-Remaining", strconv.FormatFloat(math.Floor(remaining), 'f', -1, 64))
+
+			if !allowed {
+				retryAfter := int(math.Max(1, math.Ceil((cost-remaining)/l.refillPerSecond)))
+				log.Warn().Str("client", client).Str("class", class).Msg("Rate limit exceeded")
+				w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+				utils.ErrorTooManyRequests(w,
+					fmt.Errorf("rate limit exceeded, retry in %d seconds", retryAfter)) // error 429
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+// client returns the bucket key of the client, its API key name or else its IP address. The IP address is taken
+// from X-Forwarded-For or X-Real-IP only when the request comes from a trusted proxy, other clients could forge them.
+func (l *RateLimiter) client(r *http.Request) string {
+	if apiKey, ok := APIKeyFromContext(r.Context()); ok {
+		return "key:" + apiKey.Name
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if !l.isTrustedProxy(host) {
+		return "ip:" + host
+	}
+
+	// The proxies append the address they got the request from, the rightmost address that is not a trusted proxy
+	// is the client
+	if forwarded := r.Header.Values("X-Forwarded-For"); len(forwarded) > 0 {
+		addrs := strings.Split(strings.Join(forwarded, ","), ",")
+		for i := len(addrs) - 1; i >= 0; i-- {
+			addr := strings.TrimSpace(addrs[i])
+			if net.ParseIP(addr) == nil {
+				break
+			}
+			host = addr
+			if !l.isTrustedProxy(addr) {
+				break
+			}
+		}
+		return "ip:" + host
+	}
+	if realIP := strings.TrimSpace(r.Header.Get("X-Real-IP")); net.ParseIP(realIP) != nil {
+		return "ip:" + realIP
+	}
+
+	return "ip:" + host
+}
+
+// isTrustedProxy reports whether an IP address belongs to a trusted proxy
+func (l *RateLimiter) isTrustedProxy(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, proxyNet := range l.trustedProxies {
+		if proxyNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseIPNet parses a CIDR, or a single IP address as a network of one address
+func parseIPNet(s string) (*net.IPNet, error) {
+	if strings.Contains(s, "/") {
+		_, ipNet, err := net.ParseCIDR(s)
+		return ipNet, err
+	}
+
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid IP address %q", s)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+}
+
+// tokenBucket is the state of a client bucket in memory
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// memoryTokenBuckets keeps the token buckets in memory, for a single replica
+type memoryTokenBuckets struct {
+	mu          sync.Mutex
+	now         func() time.Time
+	buckets     map[string]*tokenBucket
+	lastCleanup time.Time
+}
+
+func newMemoryTokenBuckets(now func() time.Time) *memoryTokenBuckets {
+	return &memoryTokenBuckets{now: now, buckets: make(map[string]*tokenBucket), lastCleanup: now()}
+}
+
+// TakeTokens refills the bucket of the client since its last request and takes the cost when there are enough tokens
+func (m *memoryTokenBuckets) TakeTokens(_ context.Context, key string, cost, capacity, refillPerSecond float64) (
+	bool, float64, error,
+) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+
+	// Remove the buckets that are full again, they are the same as a new bucket
+	if now.Sub(m.lastCleanup) >= bucketCleanupInterval {
+		for client, bucket := range m.buckets {
+			if bucket.tokens+now.Sub(bucket.last).Seconds()*refillPerSecond >= capacity {
+				delete(m.buckets, client)
+			}
+		}
+		m.lastCleanup = now
+	}
+
+	bucket, ok := m.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: capacity, last: now}
+		m.buckets[key] = bucket
+	}
+
+	bucket.tokens = math.Min(capacity, bucket.tokens+now.Sub(bucket.last).Seconds()*refillPerSecond)
+	bucket.last = now
+
+	if bucket.tokens < cost {
+		return false, bucket.tokens, nil
+	}
+	bucket.tokens -= cost
+	return true, bucket.tokens, nil
+}
@@ -0,0 +1,172 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// failingRateLimitStore is a rate limit store whose Redis is down
+type failingRateLimitStore struct{}
+
+func (failingRateLimitStore) TakeTokens(context.Context, string, float64, float64, float64) (bool, float64, error) {
+	return false, 0, errors.New("connection refused")
+}
+
+func TestMemoryTokenBuckets(t *testing.T) {
+	now := time.Date(2024, 6, 15, 10, 0, 0, 0, time.UTC)
+	buckets := newMemoryTokenBuckets(func() time.Time { return now })
+	ctx := context.Background()
+
+	// A new bucket is full
+	allowed, remaining, _ := buckets.TakeTokens(ctx, "ip:10.0.0.1", 5, 10, 1)
+	assert.True(t, allowed)
+	assert.Equal(t, 5.0, remaining)
+
+	allowed, remaining, _ = buckets.TakeTokens(ctx, "ip:10.0.0.1", 5, 10, 1)
+	assert.True(t, allowed)
+	assert.Equal(t, 0.0, remaining)
+
+	allowed, remaining, _ = buckets.TakeTokens(ctx, "ip:10.0.0.1", 1, 10, 1)
+	assert.False(t, allowed)
+	assert.Equal(t, 0.0, remaining)
+
+	// Every client has its own bucket
+	allowed, _, _ = buckets.TakeTokens(ctx, "ip:10.0.0.2", 1, 10, 1)
+	assert.True(t, allowed)
+
+	// The bucket refills over time, up to its capacity
+	now = now.Add(3 * time.Second)
+	allowed, remaining, _ = buckets.TakeTokens(ctx, "ip:10.0.0.1", 1, 10, 1)
+	assert.True(t, allowed)
+	assert.Equal(t, 2.0, remaining)
+
+	now = now.Add(time.Hour)
+	allowed, remaining, _ = buckets.TakeTokens(ctx, "ip:10.0.0.1", 1, 10, 1)
+	assert.True(t, allowed)
+	assert.Equal(t, 9.0, remaining)
+
+	// Buckets that are full again are removed
+	now = now.Add(time.Hour)
+	_, _, _ = buckets.TakeTokens(ctx, "ip:10.0.0.3", 1, 10, 1)
+	assert.Len(t, buckets.buckets, 1)
+}
+
+func TestRateLimiterLimit(t *testing.T) {
+	limiter := NewRateLimiter(config.RateLimitConfig{
+		Enabled:         true,
+		Capacity:        10,
+		RefillPerSecond: 0.5,
+		Costs:           map[string]float64{RouteClassSNMP: 4, RouteClassUpdate: 100, "unknown": 1},
+	}, nil)
+
+	assert.Equal(t, 1.0, limiter.costs[RouteClassCached])
+	assert.Equal(t, 4.0, limiter.costs[RouteClassSNMP])
+	assert.Equal(t, 10.0, limiter.costs[RouteClassUpdate], "a cost is capped at the capacity")
+	assert.NotContains(t, limiter.costs, "unknown")
+
+	handler := limiter.Limit(RouteClassSNMP)(okHandler)
+	request := func(remoteAddr string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = remoteAddr
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, r)
+		return recorder
+	}
+
+	assert.Equal(t, http.StatusOK, request("10.0.0.1:40000").Code)
+	assert.Equal(t, http.StatusOK, request("10.0.0.1:40001").Code)
+
+	// 2 tokens left, the next 4 tokens need 4 more seconds at 0.5 token per second
+	recorder := request("10.0.0.1:40002")
+	assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	assert.Equal(t, "4", recorder.Header().Get("Retry-After"))
+	assert.Equal(t, "2", recorder.Header().Get("X-RateLimit-Remaining"))
+
+	// Another client is not limited
+	assert.Equal(t, http.StatusOK, request("10.0.0.2:40000").Code)
+}
+
+func TestRateLimiterKeyedByAPIKey(t *testing.T) {
+	limiter := NewRateLimiter(config.RateLimitConfig{Enabled: true, Capacity: 1, RefillPerSecond: 1}, nil)
+	auth := newTestAuthenticator(&fakeAPIKeyRepo{})
+	handler := auth.RequireRole(model.RoleViewer)(limiter.Limit(RouteClassCached)(okHandler))
+
+	request := func(remoteAddr, key string) int {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = remoteAddr
+		r.Header.Set(APIKeyHeader, key)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, r)
+		return recorder.Code
+	}
+
+	// The same key from two addresses shares a bucket, two keys from the same address do not
+	assert.Equal(t, http.StatusOK, request("10.0.0.1:40000", "viewer-key"))
+	assert.Equal(t, http.StatusTooManyRequests, request("10.0.0.2:40000", "viewer-key"))
+	assert.Equal(t, http.StatusOK, request("10.0.0.1:40000", "operator-key"))
+}
+
+func TestRateLimiterStoreError(t *testing.T) {
+	limiter := NewRateLimiter(config.RateLimitConfig{Enabled: true}, failingRateLimitStore{})
+
+	recorder := httptest.NewRecorder()
+	limiter.Limit(RouteClassUpdate)(okHandler).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestRateLimiterDisabled(t *testing.T) {
+	limiter := NewRateLimiter(config.RateLimitConfig{Capacity: 1}, nil)
+	handler := limiter.Limit(RouteClassUpdate)(okHandler)
+
+	for i := 0; i < 3; i++ {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	}
+}
+
+func TestRateLimiterClient(t *testing.T) {
+	limiter := NewRateLimiter(config.RateLimitConfig{
+		TrustedProxies: []string{"10.0.0.1", " 192.168.0.0/24", "not-an-ip"},
+	}, nil)
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		header     map[string]string
+		want       string
+	}{
+		{name: "direct client", remoteAddr: "172.16.0.5:40000", want: "ip:172.16.0.5"},
+		{name: "forged header from untrusted client", remoteAddr: "172.16.0.5:40000",
+			header: map[string]string{"X-Forwarded-For": "1.2.3.4", "X-Real-IP": "1.2.3.4"}, want: "ip:172.16.0.5"},
+		{name: "forwarded for", remoteAddr: "10.0.0.1:40000",
+			header: map[string]string{"X-Forwarded-For": "203.0.113.7"}, want: "ip:203.0.113.7"},
+		{name: "forwarded through proxy chain", remoteAddr: "10.0.0.1:40000",
+			header: map[string]string{"X-Forwarded-For": "1.2.3.4, 203.0.113.7, 192.168.0.20"}, want: "ip:203.0.113.7"},
+		{name: "only proxies", remoteAddr: "10.0.0.1:40000",
+			header: map[string]string{"X-Forwarded-For": "192.168.0.30, 192.168.0.20"}, want: "ip:192.168.0.30"},
+		{name: "real ip", remoteAddr: "192.168.0.10:40000",
+			header: map[string]string{"X-Real-IP": "203.0.113.8"}, want: "ip:203.0.113.8"},
+		{name: "proxy without header", remoteAddr: "10.0.0.1:40000", want: "ip:10.0.0.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.RemoteAddr = tt.remoteAddr
+			for name, value := range tt.header {
+				r.Header.Set(name, value)
+			}
+			assert.Equal(t, tt.want, limiter.client(r))
+		})
+	}
+}
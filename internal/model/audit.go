@@ -0,0 +1,54 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Audit results
+const (
+	AuditResultSuccess = "success"
+	AuditResultFailure = "failure"
+)
+
+// AuditEntry struct is a struct that represent a write or administrative operation in the audit log
+type AuditEntry struct {
+	Time       time.Time       `json:"time"`
+	RequestID  string          `json:"request_id,omitempty"`
+	Actor      string          `json:"actor"`          // API key name, or client IP address without authentication
+	Role       string          `json:"role,omitempty"` // API key role
+	RemoteAddr string          `json:"remote_addr"`
+	Action     string          `json:"action"`
+	Board      int             `json:"board,omitempty"`
+	PON        int             `json:"pon,omitempty"`
+	OnuID      int             `json:"onu_id,omitempty"`
+	Before     json.RawMessage `json:"before,omitempty"`
+	After      json.RawMessage `json:"after,omitempty"`
+	Result     string          `json:"result"` // success or failure
+	StatusCode int             `json:"status_code"`
+	Error      string          `json:"error,omitempty"` // SNMP or CLI error of a failed operation
+}
+
+// AuditFilter struct is a struct that represent the filters of an audit log query, zero values match everything
+type AuditFilter struct {
+	Actor  string
+	Action string
+	Board  int
+	PON    int
+	OnuID  int
+	Result string
+	From   time.Time
+	To     time.Time
+}
+
+// Match returns true when the audit entry matches every filter
+func (f AuditFilter) Match(entry AuditEntry) bool {
+	return (f.Actor == "" || f.Actor == entry.Actor) &&
+		(f.Action == "" || f.Action == entry.Action) &&
+		(f.Board == 0 || f.Board == entry.Board) &&
+		(f.PON == 0 || f.PON == entry.PON) &&
+		(f.OnuID == 0 || f.OnuID == entry.OnuID) &&
+		(f.Result == "" || f.Result == entry.Result) &&
+		(f.From.IsZero() || !entry.Time.Before(f.From)) &&
+		(f.To.IsZero() || entry.Time.Before(f.To))
+}
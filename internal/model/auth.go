@@ -0,0 +1,14 @@
+package model
+
+// API key roles, every role can do what the roles before it can do
+const (
+	RoleViewer      = "viewer"      // read the ONU data
+	RoleOperator    = "operator"    // refresh cached data and run operational actions
+	RoleProvisioner = "provisioner" // change the ONU configuration
+)
+
+// APIKey struct is a struct that represent the owner and role of an API key, the key itself is only stored hashed
+type APIKey struct {
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
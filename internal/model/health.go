@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// Health statuses
+const (
+	HealthStatusUp   = "up"
+	HealthStatusDown = "down"
+)
+
+// DependencyStatus struct is a struct that represent the last check of a dependency of the service
+type DependencyStatus struct {
+	Name          string     `json:"name"`
+	Status        string     `json:"status"` // up or down
+	Detail        string     `json:"detail,omitempty"`
+	LatencyMs     float64    `json:"latency_ms"`
+	CheckedAt     time.Time  `json:"checked_at"`
+	LastSuccessAt *time.Time `json:"last_success_at,omitempty"`
+	LastError     string     `json:"last_error,omitempty"` // kept after the dependency recovers
+	LastErrorAt   *time.Time `json:"last_error_at,omitempty"`
+}
+
+// Readiness struct is a struct that represent the readiness of the service, up when every dependency is up
+type Readiness struct {
+	Status       string             `json:"status"`
+	Dependencies []DependencyStatus `json:"dependencies"`
+}
@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// Measurement struct is a struct that represent a set of numeric readings of an ONU, UNI port or PON taken by a
+// collection cycle of the exporter, it is written to the output sinks such as InfluxDB
+type Measurement struct {
+	Name   string             // zte_onu, zte_onu_uni or zte_pon
+	Tags   map[string]string  // board, pon, onu_id and port
+	Fields map[string]float64 // e.g. rx_power_dbm
+	Time   time.Time          // when the readings were collected
+}
@@ -1,20 +1,38 @@
 package model
 
+import "time"
+
 // OltConfig struct is a struct that represent the OLT configuration
 type OltConfig struct {
-	BaseOID                   string
-	OnuIDNameOID              string
-	OnuTypeOID                string
-	OnuSerialNumberOID        string
-	OnuRxPowerOID             string
-	OnuTxPowerOID             string
-	OnuStatusOID              string
-	OnuIPAddressOID           string
-	OnuDescriptionOID         string
-	OnuLastOnlineOID          string
-	OnuLastOfflineOID         string
-	OnuLastOfflineReasonOID   string
-	OnuGponOpticalDistanceOID string
+	BaseOID                      string
+	OnuIDNameOID                 string
+	OnuTypeOID                   string
+	OnuSerialNumberOID           string
+	OnuRxPowerOID                string
+	OnuTxPowerOID                string
+	OnuStatusOID                 string
+	OnuIPAddressOID              string
+	OnuDescriptionOID            string
+	OnuLastOnlineOID             string
+	OnuLastOfflineOID            string
+	OnuLastOfflineReasonOID      string
+	OnuGponOpticalDistanceOID    string
+	OnuUniAdminStateOID          string
+	OnuUniLinkStateOID           string
+	OnuUniSpeedOID               string
+	OnuUniDuplexOID              string
+	OnuHardwareVersionOID        string
+	OnuSoftwareVersionActiveOID  string
+	OnuSoftwareVersionStandbyOID string
+	OnuEquipmentIDOID            string
+	OnuUpstreamBytesOID          string
+	OnuDownstreamBytesOID        string
+	OnuUpstreamPacketsOID        string
+	OnuDownstreamPacketsOID      string
+	OnuOltRxPowerOID             string
+	OnuTemperatureOID            string
+	OnuVoltageOID                string
+	OnuBiasCurrentOID            string
 }
 
 // ONUInfo struct is a struct that represent the ONU information
@@ -32,28 +50,73 @@ type ONUInfoPerBoard struct {
 	OnuType      string `json:"onu_type"`
 	SerialNumber string `json:"serial_number"`
 	RXPower      string `json:"rx_power"`
+	OltRXPower   string `json:"olt_rx_power"`
 	Status       string `json:"status"`
 }
 
 // ONUCustomerInfo struct is a struct that represent the detailed ONU information for customer
 type ONUCustomerInfo struct {
-	Board                int    `json:"board"`
-	PON                  int    `json:"pon"`
-	ID                   int    `json:"onu_id"`
-	Name                 string `json:"name"`
-	Description          string `json:"description"`
-	OnuType              string `json:"onu_type"`
-	SerialNumber         string `json:"serial_number"`
-	RXPower              string `json:"rx_power"`
-	TXPower              string `json:"tx_power"`
-	Status               string `json:"status"`
-	IPAddress            string `json:"ip_address"`
-	LastOnline           string `json:"last_online"`
-	LastOffline          string `json:"last_offline"`
-	Uptime               string `json:"uptime"`
-	LastDownTimeDuration string `json:"last_down_time_duration"`
-	LastOfflineReason    string `json:"offline_reason"`
-	GponOpticalDistance  string `json:"gpon_optical_distance"`
+	Board                  int        `json:"board"`
+	PON                    int        `json:"pon"`
+	ID                     int        `json:"onu_id"`
+	Name                   string     `json:"name"`
+	Description            string     `json:"description"`
+	OnuType                string     `json:"onu_type"`
+	SerialNumber           string     `json:"serial_number"`
+	RXPower                string     `json:"rx_power"`
+	TXPower                string     `json:"tx_power"`
+	OltRXPower             string     `json:"olt_rx_power"`
+	Temperature            string     `json:"temperature"`
+	Voltage                string     `json:"voltage"`
+	BiasCurrent            string     `json:"bias_current"`
+	Status                 string     `json:"status"`
+	IPAddress              string     `json:"ip_address"`
+	LastOnline             string     `json:"last_online"`
+	LastOffline            string     `json:"last_offline"`
+	Uptime                 string     `json:"uptime"`
+	LastDownTimeDuration   string     `json:"last_down_time_duration"`
+	LastOfflineReason      string     `json:"offline_reason"`
+	GponOpticalDistance    string     `json:"gpon_optical_distance"`
+	HardwareVersion        string     `json:"hardware_version"`
+	SoftwareVersionActive  string     `json:"software_version_active"`
+	SoftwareVersionStandby string     `json:"software_version_standby"`
+	EquipmentID            string     `json:"equipment_id"`
+	Traffic                ONUTraffic `json:"traffic"`
+	TrafficRead            bool       `json:"-"` // Whether a traffic counter was read, Traffic is all zero otherwise
+}
+
+// ONUTraffic struct is a struct that represent the ONU traffic counters and the rates computed between two polls
+type ONUTraffic struct {
+	UpstreamBytes         uint64  `json:"upstream_bytes"`
+	DownstreamBytes       uint64  `json:"downstream_bytes"`
+	UpstreamPackets       uint64  `json:"upstream_packets"`
+	DownstreamPackets     uint64  `json:"downstream_packets"`
+	UpstreamBitRate       float64 `json:"upstream_bps"`
+	DownstreamBitRate     float64 `json:"downstream_bps"`
+	UpstreamPacketRate    float64 `json:"upstream_pps"`
+	DownstreamPacketRate  float64 `json:"downstream_pps"`
+	RateIntervalInSeconds float64 `json:"rate_interval_seconds"`
+}
+
+// OnuTrafficInfo struct is a struct that represent the ONU traffic of a single ONU in a PON
+type OnuTrafficInfo struct {
+	Board   int        `json:"board"`
+	PON     int        `json:"pon"`
+	ID      int        `json:"onu_id"`
+	Name    string     `json:"name"`
+	Traffic ONUTraffic `json:"traffic"`
+}
+
+// ONUUniPort struct is a struct that represent the status of an ONU Ethernet UNI port
+type ONUUniPort struct {
+	Board      int    `json:"board"`
+	PON        int    `json:"pon"`
+	ID         int    `json:"onu_id"`
+	Port       int    `json:"port"`
+	AdminState string `json:"admin_state"`
+	LinkState  string `json:"link_state"`
+	Speed      string `json:"speed"`
+	Duplex     string `json:"duplex"`
 }
 
 // OnuID struct is a struct that represent the ONU ID
@@ -84,8 +147,71 @@ type OnuSerialNumber struct {
 	SerialNumber string `json:"serial_number"`
 }
 
+// OnuVersion struct is a struct that represent the ONU type and active firmware version of a single ONU
+type OnuVersion struct {
+	Board           int    `json:"board"`
+	PON             int    `json:"pon"`
+	ID              int    `json:"onu_id"`
+	OnuType         string `json:"onu_type"`
+	SoftwareVersion string `json:"software_version"`
+}
+
+// OnuFirmwareInventory struct is a struct that represent the ONU count grouped by ONU type and firmware version
+type OnuFirmwareInventory struct {
+	OnuType         string  `json:"onu_type"`
+	SoftwareVersion string  `json:"software_version"`
+	Count           int     `json:"count"`
+	Onus            []OnuID `json:"onus"`
+}
+
+// OnuListFilter struct is a struct that represent the filters and sort order of an ONU list
+type OnuListFilter struct {
+	IDs                []int
+	Statuses           []string
+	OnuTypes           []string
+	NameContains       string
+	RxPowerLessThan    *float64
+	RxPowerGreaterThan *float64
+	SortBy             string
+	SortDescending     bool
+}
+
+// IsEmpty returns true when the filter neither filters nor sorts
+func (f OnuListFilter) IsEmpty() bool {
+	return len(f.IDs) == 0 && len(f.Statuses) == 0 && len(f.OnuTypes) == 0 && f.NameContains == "" &&
+		f.RxPowerLessThan == nil && f.RxPowerGreaterThan == nil && f.SortBy == ""
+}
+
 // PaginationResult struct is a struct that represent the pagination result
 type PaginationResult struct {
 	OnuInformationList []ONUInfoPerBoard
 	Count              int
 }
+
+// OnuEvent struct is a struct that represent a change of an ONU detected between two polls of its PON
+type OnuEvent struct {
+	ID              uint64    `json:"id"`
+	Type            string    `json:"type"`
+	Board           int       `json:"board"`
+	PON             int       `json:"pon"`
+	OnuID           int       `json:"onu_id"`
+	Name            string    `json:"name"`
+	SerialNumber    string    `json:"serial_number"`
+	Status          string    `json:"status"`
+	PreviousStatus  string    `json:"previous_status,omitempty"`
+	RXPower         string    `json:"rx_power"`
+	PreviousRXPower string    `json:"previous_rx_power,omitempty"`
+	Time            time.Time `json:"time"`
+}
+
+// OnuLiveReading struct is a struct that represent a single poll of the optical power and status of an ONU
+type OnuLiveReading struct {
+	Board   int       `json:"board"`
+	PON     int       `json:"pon"`
+	ID      int       `json:"onu_id"`
+	RXPower string    `json:"rx_power"`
+	TXPower string    `json:"tx_power"`
+	Status  string    `json:"status"`
+	Time    time.Time `json:"time"`
+	Error   string    `json:"error,omitempty"`
+}
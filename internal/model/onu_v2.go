@@ -0,0 +1,47 @@
+package model
+
+import "time"
+
+// ONUInfoPerBoardV2 struct is a struct that represent the typed ONU information per board for the v2 API
+type ONUInfoPerBoardV2 struct {
+	Board        int      `json:"board"`
+	PON          int      `json:"pon"`
+	ID           int      `json:"onu_id"`
+	Name         string   `json:"name"`
+	OnuType      *string  `json:"onu_type"`
+	SerialNumber *string  `json:"serial_number"`
+	RXPower      *float64 `json:"rx_power_dbm"`
+	OltRXPower   *float64 `json:"olt_rx_power_dbm"`
+	Status       string   `json:"status"`
+}
+
+// ONUCustomerInfoV2 struct is a struct that represent the typed detailed ONU information for the v2 API.
+// Unavailable values are null, timestamps are RFC3339 with the OLT UTC offset and durations are in seconds
+type ONUCustomerInfoV2 struct {
+	Board                  int         `json:"board"`
+	PON                    int         `json:"pon"`
+	ID                     int         `json:"onu_id"`
+	Name                   string      `json:"name"`
+	Description            *string     `json:"description"`
+	OnuType                *string     `json:"onu_type"`
+	SerialNumber           *string     `json:"serial_number"`
+	RXPower                *float64    `json:"rx_power_dbm"`
+	TXPower                *float64    `json:"tx_power_dbm"`
+	OltRXPower             *float64    `json:"olt_rx_power_dbm"`
+	Temperature            *float64    `json:"temperature_celsius"`
+	Voltage                *float64    `json:"voltage_volts"`
+	BiasCurrent            *float64    `json:"bias_current_ma"`
+	Status                 string      `json:"status"`
+	IPAddress              *string     `json:"ip_address"`
+	LastOnline             *time.Time  `json:"last_online"`
+	LastOffline            *time.Time  `json:"last_offline"`
+	UptimeSeconds          *int64      `json:"uptime_seconds"`
+	LastDownTimeSeconds    *int64      `json:"last_down_time_seconds"`
+	LastOfflineReason      *string     `json:"offline_reason"`
+	GponOpticalDistance    *int        `json:"gpon_optical_distance_m"`
+	HardwareVersion        *string     `json:"hardware_version"`
+	SoftwareVersionActive  *string     `json:"software_version_active"`
+	SoftwareVersionStandby *string     `json:"software_version_standby"`
+	EquipmentID            *string     `json:"equipment_id"`
+	Traffic                *ONUTraffic `json:"traffic"`
+}
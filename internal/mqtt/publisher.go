@@ -0,0 +1,335 @@
+package mqtt
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/events"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/rs/zerolog/log"
+)
+
+// Defaults of the publisher, used for zero values of config.MqttConfig.
+const (
+	defaultTopicPrefix   = "olt"
+	defaultClientID      = "go-snmp-olt-zte-c320"
+	defaultTimeout       = 10 * time.Second
+	disconnectQuiesceMs  = 250
+	statusOnline         = "online"
+	statusOffline        = "offline"
+	maxConnectRetryDelay = time.Minute
+)
+
+// topicEscaper replaces the characters that are not allowed in a topic level
+var topicEscaper = strings.NewReplacer("/", "_", "+", "_", "#", "_")
+
+// ponKey identifies a PON of a board
+type ponKey struct {
+	board int
+	pon   int
+}
+
+// Publisher publishes the state of every ONU as a retained message and the ONU events to an MQTT broker:
+//
+//	<prefix>/<olt>/board/<b>/pon/<p>/onu/<o>/state         retained model.ONUInfoPerBoard
+//	<prefix>/<olt>/board/<b>/pon/<p>/onu/<o>/event/<type>  model.OnuEvent
+//	<prefix>/<olt>/status                                   retained online or offline
+//
+// A state is only published when it changed, the retained state of an ONU that is gone is cleared.
+type Publisher struct {
+	client  paho.Client
+	topic   string // <prefix>/<olt>
+	qos     byte
+	timeout time.Duration
+
+	mu         sync.Mutex
+	published  map[ponKey]map[int][]byte // last state payload of every ONU, per PON
+	connection int                       // number of connects, states of a previous connection are not kept
+}
+
+// NewPublisher creates a new Publisher for the broker of cfg, olt is the topic level of the OLT unless cfg
+// sets one. The broker is connected by Start.
+func NewPublisher(cfg config.MqttConfig, olt string) (*Publisher, error) {
+	if cfg.BrokerURL == "" {
+		return nil, errors.New("mqtt broker url is required")
+	}
+	if cfg.QoS < 0 || cfg.QoS > 2 {
+		return nil, fmt.Errorf("invalid mqtt qos %d, must be 0, 1 or 2", cfg.QoS)
+	}
+
+	if cfg.OltName != "" {
+		olt = cfg.OltName
+	}
+	prefix := cfg.TopicPrefix
+	if prefix == "" {
+		prefix = defaultTopicPrefix
+	}
+	clientID := cfg.ClientID
+	if clientID == "" {
+		clientID = defaultClientID + "-" + olt
+	}
+	timeout := time.Duration(cfg.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	p := &Publisher{
+		topic:     strings.TrimSuffix(prefix, "/") + "/" + topicEscaper.Replace(olt),
+		qos:       byte(cfg.QoS),
+		timeout:   timeout,
+		published: make(map[ponKey]map[int][]byte),
+	}
+
+	opts := paho.NewClientOptions().
+		AddBroker(cfg.BrokerURL).
+		SetClientID(clientID).
+		SetUsername(cfg.Username).
+		SetPassword(cfg.Password).
+		SetConnectTimeout(timeout).
+		SetWriteTimeout(timeout).
+		SetAutoReconnect(true).
+		SetConnectRetry(true).
+		SetMaxReconnectInterval(maxConnectRetryDelay).
+		SetOrderMatters(false).
+		SetBinaryWill(p.topic+"/status", []byte(statusOffline), p.qos, true).
+		SetOnConnectHandler(p.onConnect).
+		SetConnectionLostHandler(func(_ paho.Client, err error) {
+			log.Warn().Err(err).Msg("Lost connection to MQTT broker")
+		})
+
+	tlsConfig, err := newTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		opts.SetTLSConfig(tlsConfig)
+	}
+
+	p.client = paho.NewClient(opts)
+	return p, nil
+}
+
+// newTLSConfig returns the TLS configuration of the broker connection, nil when cfg sets nothing and the
+// scheme of the broker URL decides, an ssl:// broker is then verified with the system roots.
+func newTLSConfig(cfg config.MqttConfig) (*tls.Config, error) {
+	if cfg.TLSCAFile == "" && cfg.TLSCertFile == "" && cfg.TLSKeyFile == "" && !cfg.TLSInsecureSkipVerify {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.TLSInsecureSkipVerify, // Opt-in for brokers with a self-signed certificate
+	}
+	if cfg.TLSCAFile != "" {
+		ca, err := os.ReadFile(cfg.TLSCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read mqtt ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificate found in mqtt ca file %s", cfg.TLSCAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if cfg.TLSCertFile != "" || cfg.TLSKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load mqtt client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// Start connects to the broker in the background, retrying until it answers, and publishes the events of the
+// broker until the context is done. Then the OLT status is set to offline and the connection is closed.
+func (p *Publisher) Start(ctx context.Context, broker *events.Broker) {
+	// With connect retry the token only completes once connected, the states of the polls until then are lost
+	p.client.Connect()
+
+	go func() {
+		p.publishEvents(ctx, broker)
+
+		_ = p.publish(p.topic+"/status", true, []byte(statusOffline))
+		p.client.Disconnect(disconnectQuiesceMs)
+	}()
+}
+
+// onConnect marks the OLT online and forgets the published states, so every state is published again in case
+// the broker lost its retained messages
+func (p *Publisher) onConnect(client paho.Client) {
+	log.Info().Msg("Connected to MQTT broker")
+
+	p.mu.Lock()
+	p.published = make(map[ponKey]map[int][]byte)
+	p.connection++
+	p.mu.Unlock()
+
+	// Not waited for, the handler runs on the connection goroutine of the client
+	client.Publish(p.topic+"/status", p.qos, true, statusOnline)
+}
+
+// WriteState implements events.StateSink. The retained state of every ONU of the PON that changed since the last
+// poll is published, and cleared for the ONUs that are gone. A state that could not be published is retried on
+// the next poll.
+func (p *Publisher) WriteState(ctx context.Context, boardID, ponID int, onuInfoList []model.ONUInfoPerBoard) {
+	if ctx.Err() != nil || !p.client.IsConnectionOpen() {
+		return
+	}
+
+	key := ponKey{board: boardID, pon: ponID}
+	p.mu.Lock()
+	previous, connection := p.published[key], p.connection
+	p.mu.Unlock()
+
+	type pending struct {
+		onuID   int
+		payload []byte
+		token   paho.Token
+	}
+	var tokens []pending
+
+	current := make(map[int]bool, len(onuInfoList))
+	for _, onuInfo := range onuInfoList {
+		current[onuInfo.ID] = true
+
+		payload, err := json.Marshal(onuInfo)
+		if err != nil {
+			log.Error().Err(err).Int("board", boardID).Int("pon", ponID).Int("onu_id", onuInfo.ID).
+				Msg("Failed to marshal ONU state")
+			continue
+		}
+		if last, ok := previous[onuInfo.ID]; ok && bytes.Equal(last, payload) {
+			continue
+		}
+		token := p.client.Publish(p.stateTopic(boardID, ponID, onuInfo.ID), p.qos, true, payload)
+		tokens = append(tokens, pending{onuID: onuInfo.ID, payload: payload, token: token})
+	}
+
+	// An empty retained message removes the retained state of an ONU from the broker
+	for onuID := range previous {
+		if !current[onuID] {
+			token := p.client.Publish(p.stateTopic(boardID, ponID, onuID), p.qos, true, []byte{})
+			tokens = append(tokens, pending{onuID: onuID, token: token})
+		}
+	}
+
+	published := make(map[int][]byte, len(onuInfoList))
+	for onuID, payload := range previous {
+		if current[onuID] {
+			published[onuID] = payload
+		}
+	}
+
+	var failed int
+	var lastErr error
+	for _, pending := range tokens {
+		if err := p.wait(pending.token); err != nil {
+			failed++
+			lastErr = err
+			if pending.payload == nil {
+				published[pending.onuID] = []byte{} // Cleared again on the next poll
+			} else {
+				delete(published, pending.onuID)
+			}
+			continue
+		}
+		if pending.payload != nil {
+			published[pending.onuID] = pending.payload
+		}
+	}
+	if failed > 0 {
+		log.Warn().Err(lastErr).Int("board", boardID).Int("pon", ponID).Int("failed", failed).
+			Msg("Failed to publish ONU states to MQTT")
+	}
+
+	p.mu.Lock()
+	if p.connection == connection {
+		p.published[key] = published
+	}
+	p.mu.Unlock()
+}
+
+// publishEvents publishes the events of the broker until the context is done. A publisher that falls behind is
+// disconnected by the broker, it subscribes again and catches up from the buffer of the broker.
+func (p *Publisher) publishEvents(ctx context.Context, broker *events.Broker) {
+	var lastEventID uint64
+	for {
+		backlog, eventCh, unsubscribe := broker.Subscribe(events.Filter{}, lastEventID)
+		for _, event := range backlog {
+			p.publishEvent(event)
+			lastEventID = event.ID
+		}
+
+		func() {
+			defer unsubscribe()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case event, ok := <-eventCh:
+					if !ok {
+						return
+					}
+					p.publishEvent(event)
+					lastEventID = event.ID
+				}
+			}
+		}()
+
+		// The broker is closed by the watcher once the context is done
+		if ctx.Err() != nil {
+			return
+		}
+		log.Warn().Uint64("last_event_id", lastEventID).Msg("MQTT publisher fell behind the ONU events, resuming")
+	}
+}
+
+// publishEvent publishes an event on the event topic of its type
+func (p *Publisher) publishEvent(event model.OnuEvent) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Error().Err(err).Uint64("event_id", event.ID).Msg("Failed to marshal ONU event")
+		return
+	}
+
+	topic := fmt.Sprintf("%s/event/%s", p.onuTopic(event.Board, event.PON, event.OnuID), event.Type)
+	if err := p.publish(topic, false, payload); err != nil {
+		log.Warn().Err(err).Uint64("event_id", event.ID).Str("type", event.Type).
+			Msg("Failed to publish ONU event to MQTT")
+	}
+}
+
+// publish publishes a message and waits until it is sent, or acknowledged by the broker with QoS 1 and 2
+func (p *Publisher) publish(topic string, retained bool, payload []byte) error {
+	return p.wait(p.client.Publish(topic, p.qos, retained, payload))
+}
+
+// wait waits for a token until the publish timeout
+func (p *Publisher) wait(token paho.Token) error {
+	if !token.WaitTimeout(p.timeout) {
+		return fmt.Errorf("mqtt publish timed out after %s", p.timeout)
+	}
+	return token.Error()
+}
+
+// onuTopic is the topic of an ONU, the parent of its state and event topics
+func (p *Publisher) onuTopic(boardID, ponID, onuID int) string {
+	return fmt.Sprintf("%s/board/%d/pon/%d/onu/%d", p.topic, boardID, ponID, onuID)
+}
+
+// stateTopic is the topic of the retained state of an ONU
+func (p *Publisher) stateTopic(boardID, ponID, onuID int) string {
+	return p.onuTopic(boardID, ponID, onuID) + "/state"
+}
@@ -0,0 +1,198 @@
+package mqtt
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/events"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// message is a PUBLISH received by the fake broker
+type message struct {
+	Topic    string
+	Payload  string
+	QoS      byte
+	Retained bool
+}
+
+// fakeBroker is a local MQTT 3.1.1 broker that accepts every client and records the published messages. It
+// acknowledges QoS 1 and answers pings, which is all the publisher uses.
+type fakeBroker struct {
+	listener net.Listener
+	mu       sync.Mutex
+	messages []message
+}
+
+func newFakeBroker(t *testing.T) *fakeBroker {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	b := &fakeBroker{listener: listener}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go b.serve(conn)
+		}
+	}()
+	return b
+}
+
+func (b *fakeBroker) url() string {
+	return "tcp://" + b.listener.Addr().String()
+}
+
+func (b *fakeBroker) serve(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+	reader := bufio.NewReader(conn)
+
+	for {
+		header, err := reader.ReadByte()
+		if err != nil {
+			return
+		}
+		length, err := binary.ReadUvarint(reader) // MQTT uses the same 7 bit variable length encoding
+		if err != nil {
+			return
+		}
+		body := make([]byte, length)
+		if _, err := io.ReadFull(reader, body); err != nil {
+			return
+		}
+
+		switch header >> 4 {
+		case 1: // CONNECT
+			_, _ = conn.Write([]byte{0x20, 0x02, 0x00, 0x00})
+		case 3: // PUBLISH
+			qos := (header >> 1) & 0x03
+			topicLength := int(binary.BigEndian.Uint16(body))
+			msg := message{Topic: string(body[2 : 2+topicLength]), QoS: qos, Retained: header&0x01 == 1}
+			rest := body[2+topicLength:]
+			if qos > 0 {
+				_, _ = conn.Write([]byte{0x40, 0x02, rest[0], rest[1]})
+				rest = rest[2:]
+			}
+			msg.Payload = string(rest)
+			b.mu.Lock()
+			b.messages = append(b.messages, msg)
+			b.mu.Unlock()
+		case 12: // PINGREQ
+			_, _ = conn.Write([]byte{0xd0, 0x00})
+		case 14: // DISCONNECT
+			return
+		}
+	}
+}
+
+// received returns the messages published on topic
+func (b *fakeBroker) received(topic string) []message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var messages []message
+	for _, msg := range b.messages {
+		if msg.Topic == topic {
+			messages = append(messages, msg)
+		}
+	}
+	return messages
+}
+
+// startPublisher connects a publisher to the fake broker and waits until it is online
+func startPublisher(t *testing.T, broker *fakeBroker, eventBroker *events.Broker) (*Publisher, context.CancelFunc) {
+	p, err := NewPublisher(config.MqttConfig{BrokerURL: broker.url(), QoS: 1, TimeoutSeconds: 2}, "10.0.0.1")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	p.Start(ctx, eventBroker)
+
+	require.Eventually(t, func() bool { return len(broker.received("olt/10.0.0.1/status")) > 0 },
+		2*time.Second, 10*time.Millisecond)
+	return p, cancel
+}
+
+func TestPublisherWritesRetainedStates(t *testing.T) {
+	broker := newFakeBroker(t)
+	p, _ := startPublisher(t, broker, events.NewBroker(0))
+
+	onus := []model.ONUInfoPerBoard{
+		{Board: 1, PON: 8, ID: 3, Name: "Isroh", SerialNumber: "ZTEGCEEA1119", RXPower: "-20.71", Status: "Online"},
+		{Board: 1, PON: 8, ID: 4, Name: "Budi", SerialNumber: "ZTEGCEEA1120", RXPower: "-21.05", Status: "Online"},
+	}
+	p.WriteState(context.Background(), 1, 8, onus)
+
+	states := broker.received("olt/10.0.0.1/board/1/pon/8/onu/3/state")
+	require.Len(t, states, 1)
+	assert.True(t, states[0].Retained)
+	assert.Equal(t, byte(1), states[0].QoS)
+	var state model.ONUInfoPerBoard
+	require.NoError(t, json.Unmarshal([]byte(states[0].Payload), &state))
+	assert.Equal(t, onus[0], state)
+
+	// Unchanged states are not published again, an ONU that is gone has its retained state cleared
+	onus[0].Status = "LOS"
+	p.WriteState(context.Background(), 1, 8, onus[:1])
+
+	states = broker.received("olt/10.0.0.1/board/1/pon/8/onu/3/state")
+	require.Len(t, states, 2)
+	assert.Contains(t, states[1].Payload, `"status":"LOS"`)
+
+	removed := broker.received("olt/10.0.0.1/board/1/pon/8/onu/4/state")
+	require.Len(t, removed, 2)
+	assert.Empty(t, removed[1].Payload)
+	assert.True(t, removed[1].Retained)
+}
+
+func TestPublisherPublishesEvents(t *testing.T) {
+	broker := newFakeBroker(t)
+	eventBroker := events.NewBroker(0)
+	_, cancel := startPublisher(t, broker, eventBroker)
+
+	eventBroker.Publish(model.OnuEvent{
+		Type: events.TypeStatusChanged, Board: 2, PON: 7, OnuID: 4, Status: "LOS", PreviousStatus: "Online",
+	})
+
+	topic := "olt/10.0.0.1/board/2/pon/7/onu/4/event/status_changed"
+	require.Eventually(t, func() bool { return len(broker.received(topic)) == 1 }, 2*time.Second, 10*time.Millisecond)
+	event := broker.received(topic)[0]
+	assert.False(t, event.Retained)
+	assert.Contains(t, event.Payload, `"previous_status":"Online"`)
+
+	// The OLT is marked offline on shutdown
+	cancel()
+	require.Eventually(t, func() bool { return len(broker.received("olt/10.0.0.1/status")) == 2 },
+		2*time.Second, 10*time.Millisecond)
+	statuses := broker.received("olt/10.0.0.1/status")
+	assert.Equal(t, []string{statusOnline, statusOffline}, []string{statuses[0].Payload, statuses[1].Payload})
+	assert.True(t, statuses[1].Retained)
+}
+
+func TestNewPublisherValidatesConfig(t *testing.T) {
+	_, err := NewPublisher(config.MqttConfig{}, "10.0.0.1")
+	assert.Error(t, err)
+
+	_, err = NewPublisher(config.MqttConfig{BrokerURL: "tcp://localhost:1883", QoS: 3}, "10.0.0.1")
+	assert.Error(t, err)
+
+	_, err = NewPublisher(config.MqttConfig{BrokerURL: "ssl://localhost:8883", TLSCAFile: "missing.pem"}, "10.0.0.1")
+	assert.Error(t, err)
+
+	// The OLT name can not add topic levels
+	p, err := NewPublisher(config.MqttConfig{BrokerURL: "tcp://localhost:1883", OltName: "site/a#1"}, "10.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, "olt/site_a_1/board/1/pon/2/onu/3/state", p.stateTopic(1, 2, 3))
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+// APIKeysRedisKey is the Redis hash of the API keys, the field is the SHA-256 hex hash of the key and the value is
+// the model.APIKey JSON
+const APIKeysRedisKey = "api_keys"
+
+// APIKeyRepositoryInterface is an interface that represent the API key repository contract
+type APIKeyRepositoryInterface interface {
+	GetAPIKey(ctx context.Context, keyHash string) (*model.APIKey, error)
+}
+
+// API key redis repository
+type apiKeyRedisRepo struct {
+	redisClient *redis.Client
+}
+
+// NewAPIKeyRedisRepo will create an object that represent the API key repository
+func NewAPIKeyRedisRepo(redisClient *redis.Client) APIKeyRepositoryInterface {
+	return &apiKeyRedisRepo{redisClient}
+}
+
+// GetAPIKey is a method to get the API key of a key hash from redis, nil when the key does not exist
+func (r *apiKeyRedisRepo) GetAPIKey(ctx context.Context, keyHash string) (*model.APIKey, error) {
+	keyBytes, err := r.redisClient.HGet(ctx, APIKeysRedisKey, keyHash).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get api key from redis")
+		return nil, errors.Wrap(err, "apiKeyRedisRepo.GetAPIKey.redisClient.HGet")
+	}
+
+	var apiKey model.APIKey
+	if err := json.Unmarshal(keyBytes, &apiKey); err != nil {
+		log.Error().Err(err).Msg("Failed to unmarshal api key")
+		return nil, errors.Wrap(err, "apiKeyRedisRepo.GetAPIKey.json.Unmarshal")
+	}
+
+	return &apiKey, nil
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// maxAuditLineSize is the largest audit entry that can be read back, the before and after values included
+const maxAuditLineSize = 4 << 20
+
+// AuditRepositoryInterface is an interface that represent the audit log repository contract
+type AuditRepositoryInterface interface {
+	Append(ctx context.Context, entry model.AuditEntry) error
+	Scan(ctx context.Context, fn func(entry model.AuditEntry) error) error
+}
+
+// Audit log file repository, one JSON entry per line. The file is only ever opened for appending, so existing
+// entries can not be changed by the service
+type auditFileRepo struct {
+	mu   sync.Mutex
+	path string
+	file *os.File
+}
+
+// NewAuditFileRepo will create an object that represent the audit log repository, the file and its directory are
+// created when they do not exist
+func NewAuditFileRepo(path string) (AuditRepositoryInterface, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, errors.Wrap(err, "NewAuditFileRepo.os.MkdirAll")
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o640)
+	if err != nil {
+		return nil, errors.Wrap(err, "NewAuditFileRepo.os.OpenFile")
+	}
+
+	return &auditFileRepo{path: path, file: file}, nil
+}
+
+// Append is a method to append an entry to the audit log, it returns once the entry is on disk
+func (r *auditFileRepo) Append(_ context.Context, entry model.AuditEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to marshal audit entry")
+		return errors.Wrap(err, "auditFileRepo.Append.json.Marshal")
+	}
+	line = append(line, '\n')
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.file.Write(line); err != nil {
+		log.Error().Err(err).Msg("Failed to write audit entry")
+		return errors.Wrap(err, "auditFileRepo.Append.file.Write")
+	}
+	if err := r.file.Sync(); err != nil {
+		log.Error().Err(err).Msg("Failed to sync audit log")
+		return errors.Wrap(err, "auditFileRepo.Append.file.Sync")
+	}
+
+	return nil
+}
+
+// Scan is a method to read the audit log from the oldest entry, until fn returns an error or ctx is done.
+// A line that is not a valid entry, e.g. cut by a crash, is skipped
+func (r *auditFileRepo) Scan(ctx context.Context, fn func(entry model.AuditEntry) error) error {
+	file, err := os.Open(r.path)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to open audit log")
+		return errors.Wrap(err, "auditFileRepo.Scan.os.Open")
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxAuditLineSize)
+
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var entry model.AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			log.Warn().Err(err).Msg("Skipping invalid audit log line")
+			continue
+		}
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		log.Error().Err(err).Msg("Failed to read audit log")
+		return errors.Wrap(err, "auditFileRepo.Scan.scanner.Err")
+	}
+
+	return nil
+}
@@ -0,0 +1,232 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/gosnmp/gosnmp"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/metrics"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/tracing"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentedSnmpRepository records the latency, errors and timeouts of the SNMP requests of a repository, and
+// traces every request with its OID and the number of varbinds it returned
+type instrumentedSnmpRepository struct {
+	next SnmpRepositoryInterface
+	olt  string // OLT label, the SNMP target address
+}
+
+// NewInstrumentedSnmpRepository wraps an SnmpRepositoryInterface with request metrics labelled with the OLT address
+// and a span for every request
+func NewInstrumentedSnmpRepository(next SnmpRepositoryInterface, olt string) SnmpRepositoryInterface {
+	return &instrumentedSnmpRepository{next: next, olt: olt}
+}
+
+// Get to get SNMP data for the given OIDs
+func (r *instrumentedSnmpRepository) Get(ctx context.Context, oids []string) (*gosnmp.SnmpPacket, error) {
+	family := metrics.OIDsFamily(oids)
+	ctx, span := tracing.Start(ctx, "SNMP Get", attribute.String("snmp.operation", metrics.SnmpOperationGet),
+		attribute.StringSlice("snmp.oids", oids), attribute.String("snmp.oid_family", family),
+		semconv.ServerAddress(r.olt))
+
+	start := time.Now()
+	result, err := r.next.Get(ctx, oids)
+	r.observe(metrics.SnmpOperationGet, family, start, err)
+
+	if result != nil {
+		span.SetAttributes(attribute.Int("snmp.varbinds", len(result.Variables)))
+	}
+	tracing.End(span, err)
+	return result, err
+}
+
+// Walk for SNMP Walk to get all OIDs under the given OID
+func (r *instrumentedSnmpRepository) Walk(ctx context.Context, oid string, walkFunc func(pdu gosnmp.SnmpPDU) error) error {
+	family := metrics.OIDFamily(oid)
+	ctx, span := tracing.Start(ctx, "SNMP Walk", attribute.String("snmp.operation", metrics.SnmpOperationWalk),
+		attribute.String("snmp.oid", oid), attribute.String("snmp.oid_family", family), semconv.ServerAddress(r.olt))
+
+	start := time.Now()
+	varbinds := 0
+	err := r.next.Walk(ctx, oid, func(pdu gosnmp.SnmpPDU) error {
+		varbinds++
+		return walkFunc(pdu)
+	})
+	r.observe(metrics.SnmpOperationWalk, family, start, err)
+
+	span.SetAttributes(attribute.Int("snmp.varbinds", varbinds))
+	tracing.End(span, err)
+	return err
+}
+
+// observe records a finished SNMP request
+func (r *instrumentedSnmpRepository) observe(operation, family string, start time.Time, err error) {
+	metrics.SnmpRequestDuration.WithLabelValues(operation, family).Observe(time.Since(start).Seconds())
+	if err == nil {
+		return
+	}
+	metrics.SnmpRequestErrors.WithLabelValues(r.olt, operation).Inc()
+	if isTimeout(err) {
+		metrics.SnmpRequestTimeouts.WithLabelValues(r.olt, operation).Inc()
+	}
+}
+
+// isTimeout reports whether an SNMP error is a timeout, gosnmp only reports its retries running out as text
+func isTimeout(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, context.DeadlineExceeded) || strings.Contains(err.Error(), "request timeout")
+}
+
+// instrumentedOnuRedisRepo records the latency and the hit, miss and error counts of the ONU cache, and traces
+// every call
+type instrumentedOnuRedisRepo struct {
+	next OnuRedisRepositoryInterface
+}
+
+// NewInstrumentedOnuRedisRepo wraps an OnuRedisRepositoryInterface with cache metrics and a span for each method
+func NewInstrumentedOnuRedisRepo(next OnuRedisRepositoryInterface) OnuRedisRepositoryInterface {
+	return &instrumentedOnuRedisRepo{next: next}
+}
+
+// GetOnuIDCtx is a method to get onu id from redis
+func (r *instrumentedOnuRedisRepo) GetOnuIDCtx(ctx context.Context, key string) ([]model.OnuID, error) {
+	ctx, span := startRedisSpan(ctx, "GetOnuIDCtx", key)
+	start := time.Now()
+	onuID, err := r.next.GetOnuIDCtx(ctx, key)
+	observeRedisRead("GetOnuIDCtx", start, err)
+	endRedisSpan(span, err)
+	return onuID, err
+}
+
+// SetOnuIDCtx is a method to set onu id to redis
+func (r *instrumentedOnuRedisRepo) SetOnuIDCtx(ctx context.Context, key string, seconds int, onuID []model.OnuID) error {
+	ctx, span := startRedisSpan(ctx, "SetOnuIDCtx", key)
+	start := time.Now()
+	err := r.next.SetOnuIDCtx(ctx, key, seconds, onuID)
+	observeRedisWrite("SetOnuIDCtx", start, err)
+	endRedisSpan(span, err)
+	return err
+}
+
+// DeleteOnuIDCtx is a method to delete onu id from redis
+func (r *instrumentedOnuRedisRepo) DeleteOnuIDCtx(ctx context.Context, key string) error {
+	ctx, span := startRedisSpan(ctx, "DeleteOnuIDCtx", key)
+	start := time.Now()
+	err := r.next.DeleteOnuIDCtx(ctx, key)
+	observeRedisWrite("DeleteOnuIDCtx", start, err)
+	endRedisSpan(span, err)
+	return err
+}
+
+// SaveONUInfoList is a method to save onu info list to redis
+func (r *instrumentedOnuRedisRepo) SaveONUInfoList(
+	ctx context.Context, key string, seconds int, onuInfoList []model.ONUInfoPerBoard,
+) error {
+	ctx, span := startRedisSpan(ctx, "SaveONUInfoList", key)
+	start := time.Now()
+	err := r.next.SaveONUInfoList(ctx, key, seconds, onuInfoList)
+	observeRedisWrite("SaveONUInfoList", start, err)
+	endRedisSpan(span, err)
+	return err
+}
+
+// GetONUInfoList is a method to get onu info list from redis
+func (r *instrumentedOnuRedisRepo) GetONUInfoList(ctx context.Context, key string) ([]model.ONUInfoPerBoard, error) {
+	ctx, span := startRedisSpan(ctx, "GetONUInfoList", key)
+	start := time.Now()
+	onuInfoList, err := r.next.GetONUInfoList(ctx, key)
+	observeRedisRead("GetONUInfoList", start, err)
+	endRedisSpan(span, err)
+	return onuInfoList, err
+}
+
+// GetOnlyOnuIDCtx is a method to get only onu id from redis
+func (r *instrumentedOnuRedisRepo) GetOnlyOnuIDCtx(ctx context.Context, key string) ([]model.OnuOnlyID, error) {
+	ctx, span := startRedisSpan(ctx, "GetOnlyOnuIDCtx", key)
+	start := time.Now()
+	onuID, err := r.next.GetOnlyOnuIDCtx(ctx, key)
+	observeRedisRead("GetOnlyOnuIDCtx", start, err)
+	endRedisSpan(span, err)
+	return onuID, err
+}
+
+// SaveOnlyOnuIDCtx is a method to save only onu id to redis
+func (r *instrumentedOnuRedisRepo) SaveOnlyOnuIDCtx(
+	ctx context.Context, key string, seconds int, onuID []model.OnuOnlyID,
+) error {
+	ctx, span := startRedisSpan(ctx, "SaveOnlyOnuIDCtx", key)
+	start := time.Now()
+	err := r.next.SaveOnlyOnuIDCtx(ctx, key, seconds, onuID)
+	observeRedisWrite("SaveOnlyOnuIDCtx", start, err)
+	endRedisSpan(span, err)
+	return err
+}
+
+// GetOnuVersionList is a method to get the onu version list from redis
+func (r *instrumentedOnuRedisRepo) GetOnuVersionList(ctx context.Context, key string) ([]model.OnuVersion, error) {
+	ctx, span := startRedisSpan(ctx, "GetOnuVersionList", key)
+	start := time.Now()
+	onuVersionList, err := r.next.GetOnuVersionList(ctx, key)
+	observeRedisRead("GetOnuVersionList", start, err)
+	endRedisSpan(span, err)
+	return onuVersionList, err
+}
+
+// SaveOnuVersionList is a method to save the onu version list to redis
+func (r *instrumentedOnuRedisRepo) SaveOnuVersionList(
+	ctx context.Context, key string, seconds int, onuVersionList []model.OnuVersion,
+) error {
+	ctx, span := startRedisSpan(ctx, "SaveOnuVersionList", key)
+	start := time.Now()
+	err := r.next.SaveOnuVersionList(ctx, key, seconds, onuVersionList)
+	observeRedisWrite("SaveOnuVersionList", start, err)
+	endRedisSpan(span, err)
+	return err
+}
+
+// startRedisSpan starts the span of a Redis call
+func startRedisSpan(ctx context.Context, method, key string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "Redis "+method, semconv.DBSystemNameRedis, semconv.DBOperationName(method),
+		attribute.String("db.redis.key", key))
+}
+
+// endRedisSpan ends the span of a Redis call, a missing key is a cache miss rather than an error
+func endRedisSpan(span trace.Span, err error) {
+	if errors.Is(err, redis.Nil) {
+		span.SetAttributes(attribute.Bool("cache.miss", true))
+		err = nil
+	}
+	tracing.End(span, err)
+}
+
+// observeRedisRead records a cache read, a missing key is a miss and any other error an error
+func observeRedisRead(method string, start time.Time, err error) {
+	metrics.RedisRequestDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	switch {
+	case err == nil:
+		metrics.RedisCacheRequests.WithLabelValues(method, metrics.CacheResultHit).Inc()
+	case errors.Is(err, redis.Nil):
+		metrics.RedisCacheRequests.WithLabelValues(method, metrics.CacheResultMiss).Inc()
+	default:
+		metrics.RedisCacheRequests.WithLabelValues(method, metrics.CacheResultError).Inc()
+	}
+}
+
+// observeRedisWrite records a cache write, only errors are counted
+func observeRedisWrite(method string, start time.Time, err error) {
+	metrics.RedisRequestDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	if err != nil {
+		metrics.RedisCacheRequests.WithLabelValues(method, metrics.CacheResultError).Inc()
+	}
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+// rateLimitKeyPrefix is the prefix of the Redis keys of the token buckets
+const rateLimitKeyPrefix = "rate_limit:"
+
+// takeTokensScript refills the token bucket of a client since its last request and takes the cost when there are
+// enough tokens, atomically, so the limits hold across replicas. The Redis clock is used for every replica.
+var takeTokensScript = redis.NewScript(`
+local capacity = tonumber(ARGV[1])
+local refill = tonumber(ARGV[2])
+local cost = tonumber(ARGV[3])
+
+local time = redis.call('TIME')
+local now = tonumber(time[1]) + tonumber(time[2]) / 1000000
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1]) or capacity
+local ts = tonumber(bucket[2]) or now
+tokens = math.min(capacity, tokens + math.max(0, now - ts) * refill)
+
+local allowed = 0
+if tokens >= cost then
+	tokens = tokens - cost
+	allowed = 1
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(now))
+redis.call('EXPIRE', KEYS[1], math.ceil(capacity / refill) + 1)
+
+return {allowed, tostring(tokens)}
+`)
+
+// RateLimitRepositoryInterface is an interface that represent the token bucket repository contract
+type RateLimitRepositoryInterface interface {
+	TakeTokens(ctx context.Context, key string, cost, capacity, refillPerSecond float64) (
+		allowed bool, remaining float64, err error,
+	)
+}
+
+// Rate limit redis repository
+type rateLimitRedisRepo struct {
+	redisClient *redis.Client
+}
+
+// NewRateLimitRedisRepo will create an object that represent the token bucket repository
+func NewRateLimitRedisRepo(redisClient *redis.Client) RateLimitRepositoryInterface {
+	return &rateLimitRedisRepo{redisClient}
+}
+
+// TakeTokens is a method to take the cost from the token bucket of a client in redis, remaining is the number of
+// tokens left in the bucket
+func (r *rateLimitRedisRepo) TakeTokens(ctx context.Context, key string, cost, capacity, refillPerSecond float64) (
+	bool, float64, error,
+) {
+	result, err := takeTokensScript.Run(ctx, r.redisClient, []string{rateLimitKeyPrefix + key},
+		capacity, refillPerSecond, cost).Slice()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to take tokens from redis")
+		return false, 0, errors.Wrap(err, "rateLimitRedisRepo.TakeTokens.takeTokensScript.Run")
+	}
+
+	if len(result) != 2 {
+		return false, 0, errors.New("rateLimitRedisRepo.TakeTokens: unexpected script result")
+	}
+	allowed, _ := result[0].(int64)
+	tokens, _ := result[1].(string)
+	remaining, err := strconv.ParseFloat(tokens, 64)
+	if err != nil {
+		return false, 0, errors.Wrap(err, "rateLimitRedisRepo.TakeTokens.strconv.ParseFloat")
+	}
+
+	return allowed == 1, remaining, nil
+}
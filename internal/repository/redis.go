@@ -20,6 +20,8 @@ type OnuRedisRepositoryInterface interface {
 	GetONUInfoList(ctx context.Context, key string) ([]model.ONUInfoPerBoard, error)
 	GetOnlyOnuIDCtx(ctx context.Context, key string) ([]model.OnuOnlyID, error)
 	SaveOnlyOnuIDCtx(ctx context.Context, key string, seconds int, onuID []model.OnuOnlyID) error
+	GetOnuVersionList(ctx context.Context, key string) ([]model.OnuVersion, error)
+	SaveOnuVersionList(ctx context.Context, key string, seconds int, onuVersionList []model.OnuVersion) error
 }
 
 // Auth redis repository
@@ -142,3 +144,38 @@ func (r *onuRedisRepo) SaveOnlyOnuIDCtx(ctx context.Context, key string, seconds
 
 	return nil
 }
+
+// GetOnuVersionList is a method to get onu version list from redis
+func (r *onuRedisRepo) GetOnuVersionList(ctx context.Context, key string) ([]model.OnuVersion, error) {
+	onuBytes, err := r.redisClient.Get(ctx, key).Bytes()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get onu version list from redis")
+		return nil, errors.Wrap(err, "onuRedisRepo.GetOnuVersionList.redisClient.Get")
+	}
+
+	var onuVersionList []model.OnuVersion
+	if err := json.Unmarshal(onuBytes, &onuVersionList); err != nil {
+		log.Error().Err(err).Msg("Failed to unmarshal onu version list")
+		return nil, errors.Wrap(err, "onuRedisRepo.GetOnuVersionList.json.Unmarshal")
+	}
+
+	return onuVersionList, nil
+}
+
+// SaveOnuVersionList is a method to save onu version list to redis
+func (r *onuRedisRepo) SaveOnuVersionList(
+	ctx context.Context, key string, seconds int, onuVersionList []model.OnuVersion,
+) error {
+	onuBytes, err := json.Marshal(onuVersionList)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to marshal onu version list")
+		return errors.Wrap(err, "onuRedisRepo.SaveOnuVersionList.json.Marshal")
+	}
+
+	if err := r.redisClient.Set(ctx, key, onuBytes, time.Second*time.Duration(seconds)).Err(); err != nil {
+		log.Error().Err(err).Msg("Failed to set onu version list to redis")
+		return errors.Wrap(err, "onuRedisRepo.SaveOnuVersionList.redisClient.Set")
+	}
+
+	return nil
+}
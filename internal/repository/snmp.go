@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"time"
@@ -8,10 +9,12 @@ import (
 	"github.com/gosnmp/gosnmp"
 )
 
-// SnmpRepositoryInterface is an interface that represents the SNMP repository contract
+// SnmpRepositoryInterface is an interface that represents the SNMP repository contract. The context carries the
+// trace of the caller, a request is bound by the SNMP timeout and retries rather than by the context, so a
+// result shared by singleflight is not lost when the first caller goes away.
 type SnmpRepositoryInterface interface {
-	Get(oids []string) (result *gosnmp.SnmpPacket, err error)       // Get SNMP data for the given OIDs
-	Walk(oid string, walkFunc func(pdu gosnmp.SnmpPDU) error) error // Walk SNMP to get all OIDs under the given OID
+	Get(ctx context.Context, oids []string) (result *gosnmp.SnmpPacket, err error)       // Get SNMP data for the given OIDs
+	Walk(ctx context.Context, oid string, walkFunc func(pdu gosnmp.SnmpPDU) error) error // Walk SNMP to get all OIDs under the given OID
 }
 
 // snmpRepository is a struct that implements SnmpRepositoryInterface
@@ -49,7 +52,7 @@ func (r *snmpRepository) buildSNMPInstance() (*gosnmp.GoSNMP, error) {
 }
 
 // Get to get SNMP data for the given OIDs
-func (r *snmpRepository) Get(oids []string) (*gosnmp.SnmpPacket, error) {
+func (r *snmpRepository) Get(_ context.Context, oids []string) (*gosnmp.SnmpPacket, error) {
 	snmp, err := r.buildSNMPInstance() // Create a new SNMP instance
 	if err != nil {
 		return nil, err
@@ -69,7 +72,7 @@ func (r *snmpRepository) Get(oids []string) (*gosnmp.SnmpPacket, error) {
 }
 
 // Walk for SNMP Walk to get all OIDs under the given OID
-func (r *snmpRepository) Walk(oid string, walkFunc func(pdu gosnmp.SnmpPDU) error) error {
+func (r *snmpRepository) Walk(_ context.Context, oid string, walkFunc func(pdu gosnmp.SnmpPDU) error) error {
 	snmp, err := r.buildSNMPInstance()
 	if err != nil {
 		return err
@@ -0,0 +1,370 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/events"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/middleware"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/usecase"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/pkg/pagination"
+	onuv1 "github.com/megadata-dev/go-snmp-olt-zte-c320/proto/onu/v1"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// OnuServer is a struct that represent the gRPC ONU service, it shares the usecase with the HTTP handlers and
+// the ONU events with the event stream
+type OnuServer struct {
+	onuv1.UnimplementedOnuServiceServer
+	ponUsecase usecase.OnuUseCaseInterface
+	broker     *events.Broker
+}
+
+// NewOnuServer will create an object that represent the gRPC ONU service
+func NewOnuServer(ponUsecase usecase.OnuUseCaseInterface, broker *events.Broker) *OnuServer {
+	return &OnuServer{ponUsecase: ponUsecase, broker: broker}
+}
+
+// NewServer will create a gRPC server with the ONU service and server reflection registered. Every call requires
+// an API key of the viewer role when authentication is enabled, authenticator may be nil in tests.
+func NewServer(ponUsecase usecase.OnuUseCaseInterface, broker *events.Broker,
+	authenticator *middleware.Authenticator) *grpc.Server {
+	var opts []grpc.ServerOption
+	if authenticator != nil {
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(authenticator.UnaryServerInterceptor(model.RoleViewer)),
+			grpc.ChainStreamInterceptor(authenticator.StreamServerInterceptor(model.RoleViewer)),
+		)
+	}
+
+	server := grpc.NewServer(opts...)
+	onuv1.RegisterOnuServiceServer(server, NewOnuServer(ponUsecase, broker))
+	reflection.Register(server)
+	return server
+}
+
+// Stop gracefully stops the gRPC server, open streams are closed when they do not finish before the timeout
+func Stop(server *grpc.Server, timeout time.Duration) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		server.Stop()
+	}
+}
+
+// ListOnus is a method to get onu info by board id and pon id
+func (s *OnuServer) ListOnus(ctx context.Context, req *onuv1.ListOnusRequest) (*onuv1.ListOnusResponse, error) {
+
+	log.Info().Msg("Received a gRPC request to ListOnus")
+
+	if err := validateBoardAndPon(req.GetBoard(), req.GetPon()); err != nil {
+		return nil, err
+	}
+
+	// Call usecase to get data from SNMP or Redis
+	onuInfoList, err := s.ponUsecase.GetByBoardIDAndPonID(ctx, int(req.GetBoard()), int(req.GetPon()))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get data from SNMP")
+		return nil, status.Error(codes.Internal, "cannot get data from snmp")
+	}
+
+	return &onuv1.ListOnusResponse{Onus: toOnuList(onuInfoList)}, nil
+}
+
+// GetOnu is a method to get the detail of an onu by board id, pon id and onu id
+func (s *OnuServer) GetOnu(ctx context.Context, req *onuv1.GetOnuRequest) (*onuv1.GetOnuResponse, error) {
+
+	log.Info().Msg("Received a gRPC request to GetOnu")
+
+	if err := validateBoardAndPon(req.GetBoard(), req.GetPon()); err != nil {
+		return nil, err
+	}
+
+	if req.GetOnuId() < 1 || req.GetOnuId() > 128 {
+		return nil, status.Error(codes.InvalidArgument, "invalid 'onu_id' parameter. It must be between 1 and 128")
+	}
+
+	// Call usecase to get data from SNMP
+	onuInfo, err := s.ponUsecase.GetByBoardIDPonIDAndOnuIDWithFields(
+		ctx, int(req.GetBoard()), int(req.GetPon()), int(req.GetOnuId()), req.GetFields(),
+	)
+
+	if errors.Is(err, usecase.ErrUnknownOnuField) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid 'fields' parameter, %v. Valid fields are: name, %s",
+			err, strings.Join(usecase.OnuDetailFields(), ", "))
+	}
+
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get data from SNMP")
+		return nil, status.Error(codes.Internal, "cannot get data from snmp")
+	}
+
+	if onuInfo.Board == 0 && onuInfo.PON == 0 && onuInfo.ID == 0 {
+		return nil, status.Error(codes.NotFound, "data not found")
+	}
+
+	return &onuv1.GetOnuResponse{Onu: toOnuDetail(onuInfo)}, nil
+}
+
+// ListEmptyOnuIDs is a method to get the empty onu id by board id and pon id
+func (s *OnuServer) ListEmptyOnuIDs(
+	ctx context.Context, req *onuv1.ListEmptyOnuIDsRequest,
+) (*onuv1.ListEmptyOnuIDsResponse, error) {
+
+	log.Info().Msg("Received a gRPC request to ListEmptyOnuIDs")
+
+	if err := validateBoardAndPon(req.GetBoard(), req.GetPon()); err != nil {
+		return nil, err
+	}
+
+	// Call usecase to get data from SNMP or Redis
+	onuIDList, err := s.ponUsecase.GetEmptyOnuID(ctx, int(req.GetBoard()), int(req.GetPon()))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get data from SNMP")
+		return nil, status.Error(codes.Internal, "cannot get data from snmp")
+	}
+
+	onuIDs := make([]int32, 0, len(onuIDList))
+	for _, onuID := range onuIDList {
+		onuIDs = append(onuIDs, int32(onuID.ID))
+	}
+
+	return &onuv1.ListEmptyOnuIDsResponse{OnuIds: onuIDs}, nil
+}
+
+// ListOnuSerialNumbers is a method to get the onu id and serial number by board id and pon id
+func (s *OnuServer) ListOnuSerialNumbers(
+	ctx context.Context, req *onuv1.ListOnuSerialNumbersRequest,
+) (*onuv1.ListOnuSerialNumbersResponse, error) {
+
+	log.Info().Msg("Received a gRPC request to ListOnuSerialNumbers")
+
+	if err := validateBoardAndPon(req.GetBoard(), req.GetPon()); err != nil {
+		return nil, err
+	}
+
+	// Call usecase to get Serial Number from SNMP
+	serialNumberList, err := s.ponUsecase.GetOnuIDAndSerialNumber(ctx, int(req.GetBoard()), int(req.GetPon()))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get data from SNMP")
+		return nil, status.Error(codes.Internal, "cannot get data from snmp")
+	}
+
+	serialNumbers := make([]*onuv1.OnuSerialNumber, 0, len(serialNumberList))
+	for _, serialNumber := range serialNumberList {
+		serialNumbers = append(serialNumbers, &onuv1.OnuSerialNumber{
+			Board:        int32(serialNumber.Board),
+			Pon:          int32(serialNumber.PON),
+			OnuId:        int32(serialNumber.ID),
+			SerialNumber: serialNumber.SerialNumber,
+		})
+	}
+
+	return &onuv1.ListOnuSerialNumbersResponse{SerialNumbers: serialNumbers}, nil
+}
+
+// ListOnusPaginated is a method to get a page of onu info by board id and pon id
+func (s *OnuServer) ListOnusPaginated(
+	ctx context.Context, req *onuv1.ListOnusPaginatedRequest,
+) (*onuv1.ListOnusPaginatedResponse, error) {
+
+	log.Info().Msg("Received a gRPC request to ListOnusPaginated")
+
+	if err := validateBoardAndPon(req.GetBoard(), req.GetPon()); err != nil {
+		return nil, err
+	}
+
+	// Same defaults as the page and limit query parameters of the REST API
+	pageIndex, pageSize := int(req.GetPage()), int(req.GetLimit())
+	if pageIndex <= 0 {
+		pageIndex = 1
+	}
+	if pageSize <= 0 {
+		pageSize = pagination.DefaultPageSize
+	}
+
+	item, count := s.ponUsecase.GetByBoardIDAndPonIDWithPagination(
+		ctx, int(req.GetBoard()), int(req.GetPon()), pageIndex, pageSize,
+	)
+	pages := pagination.New(pageIndex, pageSize, count)
+
+	return &onuv1.ListOnusPaginatedResponse{
+		Onus:      toOnuList(item),
+		Page:      int32(pages.Page),
+		Limit:     int32(pages.PageSize),
+		PageCount: int32(pages.PageCount),
+		TotalRows: int32(pages.TotalRows),
+	}, nil
+}
+
+// WatchOnuStatus is a method to stream the onu status of a pon. The status of every onu is sent first, then an event
+// for every onu whose status changed, was added or was removed, as detected by the event watcher.
+func (s *OnuServer) WatchOnuStatus(
+	req *onuv1.WatchOnuStatusRequest, stream grpc.ServerStreamingServer[onuv1.OnuStatusEvent],
+) error {
+
+	log.Info().Msg("Received a gRPC request to WatchOnuStatus")
+
+	if err := validateBoardAndPon(req.GetBoard(), req.GetPon()); err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	boardID, ponID := int(req.GetBoard()), int(req.GetPon())
+
+	// Subscribe before reading the status, so a change in between is not lost
+	_, eventCh, unsubscribe := s.broker.Subscribe(events.Filter{Board: boardID, PON: ponID}, 0)
+	defer unsubscribe()
+
+	onuInfoList, err := s.ponUsecase.GetOnuStatusList(ctx, boardID, ponID)
+	if err != nil {
+		// Keep watching, the changes are sent once the watcher reads the OLT again
+		log.Error().Err(err).Msgf("Failed to get onu status of board %d pon %d", boardID, ponID)
+	}
+	now := time.Now()
+	for _, onuInfo := range onuInfoList {
+		if err := stream.Send(toOnuStatusEvent(onuInfo, onuInfo.Status, "", now)); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-eventCh:
+			// Closed when the client is too slow or the server stops, the client watches again for a new snapshot
+			if !ok {
+				return status.Error(codes.Unavailable, "onu status watch ended, watch again")
+			}
+			statusEvent := eventToOnuStatusEvent(event)
+			if statusEvent == nil {
+				continue
+			}
+			if err := stream.Send(statusEvent); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// validateBoardAndPon returns an INVALID_ARGUMENT error when the board id or pon id is out of range
+func validateBoardAndPon(boardID, ponID int32) error {
+	if boardID != 1 && boardID != 2 {
+		return status.Error(codes.InvalidArgument, "invalid 'board_id' parameter. It must be 1 or 2")
+	}
+	if ponID < 1 || ponID > 16 {
+		return status.Error(codes.InvalidArgument, "invalid 'pon_id' parameter. It must be between 1 and 16")
+	}
+	return nil
+}
+
+// toOnuList converts the onu list to its protobuf message
+func toOnuList(onuInfoList []model.ONUInfoPerBoard) []*onuv1.Onu {
+	onus := make([]*onuv1.Onu, 0, len(onuInfoList))
+	for _, onuInfo := range onuInfoList {
+		onus = append(onus, &onuv1.Onu{
+			Board:        int32(onuInfo.Board),
+			Pon:          int32(onuInfo.PON),
+			OnuId:        int32(onuInfo.ID),
+			Name:         onuInfo.Name,
+			OnuType:      onuInfo.OnuType,
+			SerialNumber: onuInfo.SerialNumber,
+			RxPower:      onuInfo.RXPower,
+			OltRxPower:   onuInfo.OltRXPower,
+			Status:       onuInfo.Status,
+		})
+	}
+	return onus
+}
+
+// toOnuDetail converts the onu detail to its protobuf message
+func toOnuDetail(onuInfo model.ONUCustomerInfo) *onuv1.OnuDetail {
+	return &onuv1.OnuDetail{
+		Board:                  int32(onuInfo.Board),
+		Pon:                    int32(onuInfo.PON),
+		OnuId:                  int32(onuInfo.ID),
+		Name:                   onuInfo.Name,
+		Description:            onuInfo.Description,
+		OnuType:                onuInfo.OnuType,
+		SerialNumber:           onuInfo.SerialNumber,
+		RxPower:                onuInfo.RXPower,
+		TxPower:                onuInfo.TXPower,
+		OltRxPower:             onuInfo.OltRXPower,
+		Temperature:            onuInfo.Temperature,
+		Voltage:                onuInfo.Voltage,
+		BiasCurrent:            onuInfo.BiasCurrent,
+		Status:                 onuInfo.Status,
+		IpAddress:              onuInfo.IPAddress,
+		LastOnline:             onuInfo.LastOnline,
+		LastOffline:            onuInfo.LastOffline,
+		Uptime:                 onuInfo.Uptime,
+		LastDownTimeDuration:   onuInfo.LastDownTimeDuration,
+		OfflineReason:          onuInfo.LastOfflineReason,
+		GponOpticalDistance:    onuInfo.GponOpticalDistance,
+		HardwareVersion:        onuInfo.HardwareVersion,
+		SoftwareVersionActive:  onuInfo.SoftwareVersionActive,
+		SoftwareVersionStandby: onuInfo.SoftwareVersionStandby,
+		EquipmentId:            onuInfo.EquipmentID,
+		Traffic: &onuv1.OnuTraffic{
+			UpstreamBytes:       onuInfo.Traffic.UpstreamBytes,
+			DownstreamBytes:     onuInfo.Traffic.DownstreamBytes,
+			UpstreamPackets:     onuInfo.Traffic.UpstreamPackets,
+			DownstreamPackets:   onuInfo.Traffic.DownstreamPackets,
+			UpstreamBps:         onuInfo.Traffic.UpstreamBitRate,
+			DownstreamBps:       onuInfo.Traffic.DownstreamBitRate,
+			UpstreamPps:         onuInfo.Traffic.UpstreamPacketRate,
+			DownstreamPps:       onuInfo.Traffic.DownstreamPacketRate,
+			RateIntervalSeconds: onuInfo.Traffic.RateIntervalInSeconds,
+		},
+	}
+}
+
+// eventToOnuStatusEvent converts an onu event to its protobuf message, nil for the events that are not a status
+// change
+func eventToOnuStatusEvent(event model.OnuEvent) *onuv1.OnuStatusEvent {
+	onuInfo := model.ONUInfoPerBoard{
+		Board: event.Board, PON: event.PON, ID: event.OnuID, Name: event.Name, SerialNumber: event.SerialNumber,
+	}
+
+	switch event.Type {
+	case events.TypeStatusChanged:
+		return toOnuStatusEvent(onuInfo, event.Status, event.PreviousStatus, event.Time)
+	case events.TypeOnuRegistered:
+		return toOnuStatusEvent(onuInfo, event.Status, "", event.Time)
+	case events.TypeOnuRemoved:
+		return toOnuStatusEvent(onuInfo, "", event.Status, event.Time)
+	default:
+		return nil
+	}
+}
+
+// toOnuStatusEvent converts an onu status change to its protobuf message
+func toOnuStatusEvent(
+	onuInfo model.ONUInfoPerBoard, onuStatus, previousStatus string, now time.Time,
+) *onuv1.OnuStatusEvent {
+	return &onuv1.OnuStatusEvent{
+		Board:          int32(onuInfo.Board),
+		Pon:            int32(onuInfo.PON),
+		OnuId:          int32(onuInfo.ID),
+		Name:           onuInfo.Name,
+		SerialNumber:   onuInfo.SerialNumber,
+		Status:         onuStatus,
+		PreviousStatus: previousStatus,
+		Time:           timestamppb.New(now),
+	}
+}
@@ -0,0 +1,202 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/events"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/middleware"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/usecase"
+	onuv1 "github.com/megadata-dev/go-snmp-olt-zte-c320/proto/onu/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeOnuUsecase answers the methods used by the tests, any other method panics on the nil interface
+type fakeOnuUsecase struct {
+	usecase.OnuUseCaseInterface
+	onuInfoList []model.ONUInfoPerBoard
+	onuDetail   model.ONUCustomerInfo
+}
+
+func (f *fakeOnuUsecase) GetByBoardIDAndPonID(_ context.Context, _, _ int) ([]model.ONUInfoPerBoard, error) {
+	return f.onuInfoList, nil
+}
+
+func (f *fakeOnuUsecase) GetOnuStatusList(_ context.Context, _, _ int) ([]model.ONUInfoPerBoard, error) {
+	return f.onuInfoList, nil
+}
+
+func (f *fakeOnuUsecase) GetByBoardIDPonIDAndOnuIDWithFields(_ context.Context, _, _, _ int, fields []string) (
+	model.ONUCustomerInfo, error,
+) {
+	if len(fields) > 0 && fields[0] == "unknown" {
+		return model.ONUCustomerInfo{}, usecase.ErrUnknownOnuField
+	}
+	return f.onuDetail, nil
+}
+
+func newTestClient(t *testing.T, fake *fakeOnuUsecase) onuv1.OnuServiceClient {
+	return newTestClientWithBroker(t, fake, events.NewBroker(0))
+}
+
+func newTestClientWithBroker(t *testing.T, fake *fakeOnuUsecase, broker *events.Broker) onuv1.OnuServiceClient {
+	return newTestClientWithAuth(t, fake, broker, nil)
+}
+
+func newTestClientWithAuth(t *testing.T, fake *fakeOnuUsecase, broker *events.Broker,
+	authenticator *middleware.Authenticator) onuv1.OnuServiceClient {
+	listener := bufconn.Listen(1024 * 1024)
+	server := NewServer(fake, broker, authenticator)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return onuv1.NewOnuServiceClient(conn)
+}
+
+func TestServerRequiresAPIKey(t *testing.T) {
+	authenticator := middleware.NewAuthenticator(config.AuthConfig{
+		Enabled: true,
+		APIKeys: []config.APIKeyConfig{
+			{Name: "dashboard", KeyHash: middleware.HashAPIKey("viewer-key"), Role: model.RoleViewer},
+		},
+	}, nil)
+	client := newTestClientWithAuth(t, &fakeOnuUsecase{onuInfoList: []model.ONUInfoPerBoard{
+		{Board: 1, PON: 2, ID: 3, Name: "Isroh", Status: "Online"},
+	}}, events.NewBroker(0), authenticator)
+
+	_, err := client.ListOnus(context.Background(), &onuv1.ListOnusRequest{Board: 1, Pon: 2})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	stream, err := client.WatchOnuStatus(context.Background(), &onuv1.WatchOnuStatusRequest{Board: 1, Pon: 2})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer viewer-key")
+	response, err := client.ListOnus(ctx, &onuv1.ListOnusRequest{Board: 1, Pon: 2})
+	assert.NoError(t, err)
+	assert.Len(t, response.GetOnus(), 1)
+}
+
+func TestListOnus(t *testing.T) {
+	client := newTestClient(t, &fakeOnuUsecase{onuInfoList: []model.ONUInfoPerBoard{
+		{Board: 1, PON: 2, ID: 3, Name: "Isroh", RXPower: "-20.71", Status: "Online"},
+	}})
+
+	response, err := client.ListOnus(context.Background(), &onuv1.ListOnusRequest{Board: 1, Pon: 2})
+	assert.NoError(t, err)
+	assert.Len(t, response.GetOnus(), 1)
+	assert.Equal(t, int32(3), response.GetOnus()[0].GetOnuId())
+	assert.Equal(t, "-20.71", response.GetOnus()[0].GetRxPower())
+
+	_, err = client.ListOnus(context.Background(), &onuv1.ListOnusRequest{Board: 3, Pon: 2})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetOnu(t *testing.T) {
+	client := newTestClient(t, &fakeOnuUsecase{onuDetail: model.ONUCustomerInfo{
+		Board: 1, PON: 2, ID: 3, Name: "Isroh", Traffic: model.ONUTraffic{UpstreamBytes: 10},
+	}})
+
+	response, err := client.GetOnu(context.Background(), &onuv1.GetOnuRequest{Board: 1, Pon: 2, OnuId: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, "Isroh", response.GetOnu().GetName())
+	assert.Equal(t, uint64(10), response.GetOnu().GetTraffic().GetUpstreamBytes())
+
+	_, err = client.GetOnu(context.Background(), &onuv1.GetOnuRequest{Board: 1, Pon: 2, OnuId: 129})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.GetOnu(context.Background(),
+		&onuv1.GetOnuRequest{Board: 1, Pon: 2, OnuId: 3, Fields: []string{"unknown"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	notFoundClient := newTestClient(t, &fakeOnuUsecase{})
+	_, err = notFoundClient.GetOnu(context.Background(), &onuv1.GetOnuRequest{Board: 1, Pon: 2, OnuId: 3})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestWatchOnuStatusSendsSnapshot(t *testing.T) {
+	client := newTestClient(t, &fakeOnuUsecase{onuInfoList: []model.ONUInfoPerBoard{
+		{Board: 1, PON: 2, ID: 1, Name: "Isroh", Status: "Online"},
+		{Board: 1, PON: 2, ID: 2, Name: "Budi", Status: "LOS"},
+	}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.WatchOnuStatus(ctx, &onuv1.WatchOnuStatusRequest{Board: 1, Pon: 2})
+	assert.NoError(t, err)
+
+	for _, expected := range []string{"Online", "LOS"} {
+		event, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, event.GetStatus())
+		assert.Empty(t, event.GetPreviousStatus())
+	}
+}
+
+func TestWatchOnuStatusForwardsEvents(t *testing.T) {
+	broker := events.NewBroker(0)
+	client := newTestClientWithBroker(t, &fakeOnuUsecase{onuInfoList: []model.ONUInfoPerBoard{
+		{Board: 1, PON: 2, ID: 1, Name: "Isroh", Status: "Online"},
+	}}, broker)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.WatchOnuStatus(ctx, &onuv1.WatchOnuStatusRequest{Board: 1, Pon: 2})
+	assert.NoError(t, err)
+	snapshot, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, "Online", snapshot.GetStatus())
+
+	// The rx power events and the events of other PONs are not sent
+	broker.Publish(
+		model.OnuEvent{Type: events.TypeRxPowerLow, Board: 1, PON: 2, OnuID: 1, Status: "Online"},
+		model.OnuEvent{Type: events.TypeStatusChanged, Board: 1, PON: 3, OnuID: 1, Status: "LOS"},
+		model.OnuEvent{Type: events.TypeStatusChanged, Board: 1, PON: 2, OnuID: 1, Status: "LOS", PreviousStatus: "Online"},
+		model.OnuEvent{Type: events.TypeOnuRemoved, Board: 1, PON: 2, OnuID: 1, Status: "LOS"},
+		model.OnuEvent{Type: events.TypeOnuRegistered, Board: 1, PON: 2, OnuID: 4, Status: "Online"},
+	)
+
+	type change struct {
+		onuID            int32
+		status, previous string
+	}
+	var changes []change
+	for range 3 {
+		event, err := stream.Recv()
+		assert.NoError(t, err)
+		changes = append(changes, change{event.GetOnuId(), event.GetStatus(), event.GetPreviousStatus()})
+	}
+
+	assert.Equal(t, []change{
+		{1, "LOS", "Online"},
+		{1, "", "LOS"},
+		{4, "Online", ""},
+	}, changes)
+
+	// The stream ends when the broker is closed
+	broker.Close()
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
@@ -6,10 +6,13 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/gosnmp/gosnmp"
 	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/metrics"
 	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
 	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/repository"
 	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/utils"
@@ -20,21 +23,68 @@ import (
 // OnuUseCaseInterface is an interface that represent the auth's usecase contract
 type OnuUseCaseInterface interface {
 	GetByBoardIDAndPonID(ctx context.Context, boardID, ponID int) ([]model.ONUInfoPerBoard, error)
-	GetByBoardIDPonIDAndOnuID(boardID, ponID, onuID int) (model.ONUCustomerInfo, error)
+	GetOnuStatusList(ctx context.Context, boardID, ponID int) ([]model.ONUInfoPerBoard, error)
+	GetByBoardIDAndPonIDWithFilter(ctx context.Context, boardID, ponID int, filter model.OnuListFilter) (
+		[]model.ONUInfoPerBoard, error,
+	)
+	GetAllWithFilter(ctx context.Context, filter model.OnuListFilter) ([]model.ONUInfoPerBoard, error)
+	ExportOnuInventory(
+		ctx context.Context, boardID, ponID int, filter model.OnuListFilter, detail bool,
+		fn func(model.ONUCustomerInfo) error,
+	) error
+	GetByBoardIDPonIDAndOnuID(ctx context.Context, boardID, ponID, onuID int) (model.ONUCustomerInfo, error)
+	GetByBoardIDPonIDAndOnuIDWithFields(ctx context.Context, boardID, ponID, onuID int, fields []string) (
+		model.ONUCustomerInfo, error,
+	)
+	GetOnuUniPorts(ctx context.Context, boardID, ponID, onuID int) ([]model.ONUUniPort, error)
+	GetOnuFirmwareInventory(ctx context.Context, onuType, version string) ([]model.OnuFirmwareInventory, error)
+	GetTopTalkers(ctx context.Context, boardID, ponID, limit int, direction string) ([]model.OnuTrafficInfo, error)
 	GetEmptyOnuID(ctx context.Context, boardID, ponID int) ([]model.OnuID, error)
-	GetOnuIDAndSerialNumber(boardID, ponID int) ([]model.OnuSerialNumber, error)
-	UpdateEmptyOnuID(ctx context.Context, boardID, ponID int) error
-	GetByBoardIDAndPonIDWithPagination(boardID, ponID, page, pageSize int) (
+	GetOnuIDAndSerialNumber(ctx context.Context, boardID, ponID int) ([]model.OnuSerialNumber, error)
+	UpdateEmptyOnuID(ctx context.Context, boardID, ponID int) (before, after []model.OnuID, err error)
+	GetByBoardIDAndPonIDWithPagination(ctx context.Context, boardID, ponID, page, pageSize int) (
 		[]model.ONUInfoPerBoard, int,
 	)
+	GetOltLocation() *time.Location
 }
 
+// Board, PON and ONU ID ranges supported by the ZTE C320
+const (
+	minBoardID = 1
+	maxBoardID = 2
+	minPonID   = 1
+	maxPonID   = 16
+)
+
+// defaultOltTimezone is the OLT clock timezone used when none is configured or detected (WIB, UTC+7)
+const defaultOltTimezone = "Asia/Jakarta"
+
+const (
+	// oltLocationRetryInterval is the time between two attempts to detect the "auto" timezone of the OLT
+	oltLocationRetryInterval = time.Minute
+	// defaultOltLocationTimeout bounds an attempt to detect the "auto" timezone, an unreachable OLT would otherwise
+	// hold it for every SNMP retry
+	defaultOltLocationTimeout = 5 * time.Second
+)
+
+// oltSystemDateOID is hrSystemDate from HOST-RESOURCES-MIB, an RFC 2579 DateAndTime with the OLT UTC offset
+const oltSystemDateOID = ".1.3.6.1.2.1.25.1.2.0"
+
 // onuUsecase represent the auth's usecase
 type onuUsecase struct {
 	snmpRepository  repository.SnmpRepositoryInterface
 	redisRepository repository.OnuRedisRepositoryInterface
 	cfg             *config.Config
 	sg              singleflight.Group
+	trafficMu       sync.Mutex
+	trafficSamples  map[trafficKey]trafficSample
+	trafficWait     time.Duration // Time between the two readings of a rate without a previous reading
+	locationOnce    sync.Once
+	locationMu      sync.Mutex
+	locationAttempt time.Time     // Last attempt to detect the zone of the OLT with the "auto" timezone
+	locationBusy    bool          // An attempt to detect the zone is running
+	locationTimeout time.Duration // Maximum duration of an attempt to detect the zone
+	oltLocation     *time.Location
 }
 
 // NewOnuUsecase will create an object that represent the auth usecase
@@ -47,9 +97,20 @@ func NewOnuUsecase(
 		redisRepository: redisRepository,
 		cfg:             cfg,
 		sg:              singleflight.Group{},
+		trafficSamples:  make(map[trafficKey]trafficSample),
+		trafficWait:     defaultTrafficWait,
+		locationTimeout: defaultOltLocationTimeout,
 	}
 }
 
+// doShared runs fn through the singleflight group so concurrent callers with the same key share a single SNMP
+// round trip, and counts whether the result was shared
+func (u *onuUsecase) doShared(call, key string, fn func() (interface{}, error)) (interface{}, error) {
+	result, err, shared := u.sg.Do(key, fn)
+	metrics.SingleflightCalls.WithLabelValues(call, strconv.FormatBool(shared)).Inc()
+	return result, err
+}
+
 // getOltInfo is a function to get OLT information
 func (u *onuUsecase) getOltConfig(boardID, ponID int) (*model.OltConfig, error) {
 	cfg, err := u.getBoardConfig(boardID, ponID)
@@ -78,260 +139,516 @@ func (u *onuUsecase) getBoard1Config(ponID int) *model.OltConfig {
 	switch ponID {
 	case 1:
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board1Pon1.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board1Pon1.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board1Pon1.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board1Pon1.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board1Pon1.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board1Pon1.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board1Pon1.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board1Pon1.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board1Pon1.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board1Pon1.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board1Pon1.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board1Pon1.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board1Pon1.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board1Pon1.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board1Pon1.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board1Pon1.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board1Pon1.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board1Pon1.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board1Pon1.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board1Pon1.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board1Pon1.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board1Pon1.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board1Pon1.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board1Pon1.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board1Pon1.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board1Pon1.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board1Pon1.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board1Pon1.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board1Pon1.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board1Pon1.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board1Pon1.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board1Pon1.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board1Pon1.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board1Pon1.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board1Pon1.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board1Pon1.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board1Pon1.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board1Pon1.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board1Pon1.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board1Pon1.OnuBiasCurrentOID,
 		}
 	case 2:
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board1Pon2.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board1Pon2.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board1Pon2.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board1Pon2.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board1Pon2.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board1Pon2.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board1Pon2.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board1Pon2.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board1Pon2.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board1Pon2.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board1Pon2.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board1Pon2.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board1Pon2.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board1Pon2.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board1Pon2.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board1Pon2.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board1Pon2.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board1Pon2.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board1Pon2.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board1Pon2.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board1Pon2.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board1Pon2.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board1Pon2.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board1Pon2.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board1Pon2.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board1Pon2.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board1Pon2.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board1Pon2.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board1Pon2.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board1Pon2.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board1Pon2.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board1Pon2.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board1Pon2.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board1Pon2.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board1Pon2.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board1Pon2.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board1Pon2.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board1Pon2.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board1Pon2.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board1Pon2.OnuBiasCurrentOID,
 		}
 	case 3: // PON 3
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board1Pon3.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board1Pon3.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board1Pon3.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board1Pon3.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board1Pon3.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board1Pon3.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board1Pon3.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board1Pon3.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board1Pon3.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board1Pon3.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board1Pon3.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board1Pon3.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board1Pon3.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board1Pon3.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board1Pon3.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board1Pon3.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board1Pon3.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board1Pon3.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board1Pon3.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board1Pon3.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board1Pon3.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board1Pon3.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board1Pon3.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board1Pon3.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board1Pon3.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board1Pon3.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board1Pon3.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board1Pon3.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board1Pon3.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board1Pon3.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board1Pon3.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board1Pon3.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board1Pon3.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board1Pon3.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board1Pon3.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board1Pon3.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board1Pon3.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board1Pon3.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board1Pon3.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board1Pon3.OnuBiasCurrentOID,
 		}
 	case 4: // PON 4
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board1Pon4.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board1Pon4.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board1Pon4.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board1Pon4.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board1Pon4.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board1Pon4.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board1Pon4.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board1Pon4.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board1Pon4.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board1Pon4.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board1Pon4.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board1Pon4.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board1Pon4.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board1Pon4.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board1Pon4.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board1Pon4.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board1Pon4.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board1Pon4.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board1Pon4.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board1Pon4.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board1Pon4.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board1Pon4.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board1Pon4.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board1Pon4.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board1Pon4.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board1Pon4.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board1Pon4.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board1Pon4.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board1Pon4.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board1Pon4.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board1Pon4.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board1Pon4.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board1Pon4.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board1Pon4.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board1Pon4.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board1Pon4.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board1Pon4.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board1Pon4.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board1Pon4.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board1Pon4.OnuBiasCurrentOID,
 		}
 	case 5: // PON 5
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board1Pon5.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board1Pon5.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board1Pon5.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board1Pon5.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board1Pon5.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board1Pon5.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board1Pon5.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board1Pon5.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board1Pon5.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board1Pon5.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board1Pon5.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board1Pon5.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board1Pon5.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board1Pon5.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board1Pon5.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board1Pon5.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board1Pon5.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board1Pon5.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board1Pon5.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board1Pon5.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board1Pon5.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board1Pon5.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board1Pon5.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board1Pon5.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board1Pon5.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board1Pon5.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board1Pon5.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board1Pon5.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board1Pon5.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board1Pon5.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board1Pon5.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board1Pon5.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board1Pon5.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board1Pon5.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board1Pon5.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board1Pon5.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board1Pon5.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board1Pon5.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board1Pon5.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board1Pon5.OnuBiasCurrentOID,
 		}
 	case 6: // PON 6
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board1Pon6.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board1Pon6.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board1Pon6.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board1Pon6.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board1Pon6.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board1Pon6.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board1Pon6.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board1Pon6.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board1Pon6.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board1Pon6.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board1Pon6.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board1Pon6.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board1Pon6.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board1Pon6.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board1Pon6.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board1Pon6.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board1Pon6.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board1Pon6.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board1Pon6.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board1Pon6.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board1Pon6.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board1Pon6.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board1Pon6.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board1Pon6.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board1Pon6.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board1Pon6.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board1Pon6.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board1Pon6.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board1Pon6.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board1Pon6.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board1Pon6.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board1Pon6.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board1Pon6.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board1Pon6.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board1Pon6.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board1Pon6.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board1Pon6.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board1Pon6.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board1Pon6.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board1Pon6.OnuBiasCurrentOID,
 		}
 	case 7: // PON 7
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board1Pon7.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board1Pon7.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board1Pon7.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board1Pon7.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board1Pon7.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board1Pon7.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board1Pon7.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board1Pon7.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board1Pon7.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board1Pon7.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board1Pon7.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board1Pon7.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board1Pon7.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board1Pon7.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board1Pon7.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board1Pon7.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board1Pon7.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board1Pon7.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board1Pon7.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board1Pon7.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board1Pon7.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board1Pon7.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board1Pon7.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board1Pon7.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board1Pon7.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board1Pon7.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board1Pon7.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board1Pon7.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board1Pon7.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board1Pon7.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board1Pon7.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board1Pon7.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board1Pon7.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board1Pon7.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board1Pon7.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board1Pon7.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board1Pon7.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board1Pon7.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board1Pon7.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board1Pon7.OnuBiasCurrentOID,
 		}
 	case 8: // PON 8
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board1Pon8.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board1Pon8.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board1Pon8.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board1Pon8.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board1Pon8.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board1Pon8.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board1Pon8.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board1Pon8.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board1Pon8.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board1Pon8.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board1Pon8.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board1Pon8.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board1Pon8.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board1Pon8.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board1Pon8.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board1Pon8.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board1Pon8.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board1Pon8.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board1Pon8.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board1Pon8.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board1Pon8.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board1Pon8.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board1Pon8.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board1Pon8.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board1Pon8.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board1Pon8.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board1Pon8.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board1Pon8.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board1Pon8.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board1Pon8.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board1Pon8.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board1Pon8.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board1Pon8.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board1Pon8.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board1Pon8.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board1Pon8.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board1Pon8.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board1Pon8.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board1Pon8.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board1Pon8.OnuBiasCurrentOID,
 		}
 	case 9: // PON 9
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board1Pon9.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board1Pon9.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board1Pon9.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board1Pon9.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board1Pon9.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board1Pon9.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board1Pon9.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board1Pon9.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board1Pon9.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board1Pon9.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board1Pon9.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board1Pon9.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board1Pon9.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board1Pon9.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board1Pon9.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board1Pon9.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board1Pon9.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board1Pon9.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board1Pon9.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board1Pon9.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board1Pon9.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board1Pon9.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board1Pon9.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board1Pon9.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board1Pon9.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board1Pon9.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board1Pon9.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board1Pon9.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board1Pon9.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board1Pon9.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board1Pon9.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board1Pon9.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board1Pon9.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board1Pon9.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board1Pon9.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board1Pon9.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board1Pon9.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board1Pon9.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board1Pon9.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board1Pon9.OnuBiasCurrentOID,
 		}
 	case 10: // PON 10
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board1Pon10.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board1Pon10.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board1Pon10.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board1Pon10.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board1Pon10.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board1Pon10.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board1Pon10.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board1Pon10.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board1Pon10.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board1Pon10.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board1Pon10.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board1Pon10.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board1Pon10.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board1Pon10.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board1Pon10.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board1Pon10.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board1Pon10.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board1Pon10.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board1Pon10.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board1Pon10.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board1Pon10.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board1Pon10.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board1Pon10.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board1Pon10.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board1Pon10.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board1Pon10.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board1Pon10.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board1Pon10.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board1Pon10.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board1Pon10.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board1Pon10.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board1Pon10.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board1Pon10.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board1Pon10.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board1Pon10.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board1Pon10.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board1Pon10.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board1Pon10.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board1Pon10.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board1Pon10.OnuBiasCurrentOID,
 		}
 	case 11: // PON 11
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board1Pon11.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board1Pon11.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board1Pon11.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board1Pon11.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board1Pon11.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board1Pon11.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board1Pon11.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board1Pon11.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board1Pon11.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board1Pon11.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board1Pon11.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board1Pon11.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board1Pon11.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board1Pon11.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board1Pon11.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board1Pon11.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board1Pon11.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board1Pon11.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board1Pon11.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board1Pon11.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board1Pon11.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board1Pon11.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board1Pon11.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board1Pon11.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board1Pon11.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board1Pon11.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board1Pon11.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board1Pon11.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board1Pon11.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board1Pon11.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board1Pon11.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board1Pon11.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board1Pon11.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board1Pon11.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board1Pon11.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board1Pon11.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board1Pon11.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board1Pon11.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board1Pon11.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board1Pon11.OnuBiasCurrentOID,
 		}
 	case 12: // PON 12
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board1Pon12.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board1Pon12.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board1Pon12.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board1Pon12.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board1Pon12.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board1Pon12.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board1Pon12.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board1Pon12.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board1Pon12.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board1Pon12.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board1Pon12.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board1Pon12.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board1Pon12.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board1Pon12.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board1Pon12.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board1Pon12.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board1Pon12.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board1Pon12.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board1Pon12.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board1Pon12.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board1Pon12.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board1Pon12.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board1Pon12.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board1Pon12.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board1Pon12.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board1Pon12.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board1Pon12.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board1Pon12.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board1Pon12.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board1Pon12.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board1Pon12.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board1Pon12.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board1Pon12.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board1Pon12.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board1Pon12.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board1Pon12.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board1Pon12.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board1Pon12.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board1Pon12.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board1Pon12.OnuBiasCurrentOID,
 		}
 	case 13: // PON 13
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board1Pon13.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board1Pon13.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board1Pon13.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board1Pon13.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board1Pon13.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board1Pon13.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board1Pon13.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board1Pon13.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board1Pon13.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board1Pon13.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board1Pon13.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board1Pon13.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board1Pon13.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board1Pon13.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board1Pon13.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board1Pon13.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board1Pon13.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board1Pon13.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board1Pon13.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board1Pon13.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board1Pon13.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board1Pon13.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board1Pon13.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board1Pon13.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board1Pon13.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board1Pon13.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board1Pon13.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board1Pon13.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board1Pon13.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board1Pon13.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board1Pon13.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board1Pon13.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board1Pon13.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board1Pon13.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board1Pon13.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board1Pon13.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board1Pon13.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board1Pon13.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board1Pon13.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board1Pon13.OnuBiasCurrentOID,
 		}
 	case 14: // PON 14
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board1Pon14.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board1Pon14.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board1Pon14.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board1Pon14.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board1Pon14.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board1Pon14.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board1Pon14.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board1Pon14.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board1Pon14.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board1Pon14.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board1Pon14.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board1Pon14.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board1Pon14.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board1Pon14.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board1Pon14.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board1Pon14.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board1Pon14.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board1Pon14.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board1Pon14.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board1Pon14.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board1Pon14.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board1Pon14.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board1Pon14.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board1Pon14.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board1Pon14.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board1Pon14.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board1Pon14.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board1Pon14.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board1Pon14.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board1Pon14.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board1Pon14.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board1Pon14.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board1Pon14.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board1Pon14.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board1Pon14.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board1Pon14.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board1Pon14.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board1Pon14.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board1Pon14.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board1Pon14.OnuBiasCurrentOID,
 		}
 	case 15: // PON 15
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board1Pon15.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board1Pon15.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board1Pon15.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board1Pon15.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board1Pon15.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board1Pon15.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board1Pon15.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board1Pon15.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board1Pon15.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board1Pon15.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board1Pon15.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board1Pon15.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board1Pon15.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board1Pon15.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board1Pon15.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board1Pon15.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board1Pon15.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board1Pon15.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board1Pon15.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board1Pon15.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board1Pon15.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board1Pon15.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board1Pon15.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board1Pon15.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board1Pon15.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board1Pon15.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board1Pon15.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board1Pon15.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board1Pon15.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board1Pon15.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board1Pon15.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board1Pon15.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board1Pon15.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board1Pon15.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board1Pon15.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board1Pon15.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board1Pon15.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board1Pon15.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board1Pon15.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board1Pon15.OnuBiasCurrentOID,
 		}
 
 	case 16: // PON 16
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board1Pon16.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board1Pon16.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board1Pon16.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board1Pon16.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board1Pon16.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board1Pon16.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board1Pon16.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board1Pon16.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board1Pon16.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board1Pon16.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board1Pon16.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board1Pon16.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board1Pon16.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board1Pon16.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board1Pon16.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board1Pon16.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board1Pon16.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board1Pon16.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board1Pon16.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board1Pon16.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board1Pon16.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board1Pon16.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board1Pon16.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board1Pon16.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board1Pon16.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board1Pon16.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board1Pon16.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board1Pon16.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board1Pon16.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board1Pon16.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board1Pon16.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board1Pon16.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board1Pon16.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board1Pon16.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board1Pon16.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board1Pon16.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board1Pon16.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board1Pon16.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board1Pon16.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board1Pon16.OnuBiasCurrentOID,
 		}
 
 	default:
@@ -346,261 +663,517 @@ func (u *onuUsecase) getBoard2Config(ponID int) *model.OltConfig {
 	switch ponID {
 	case 1: // PON 1
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board2Pon1.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board2Pon1.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board2Pon1.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board2Pon1.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board2Pon1.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board2Pon1.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board2Pon1.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board2Pon1.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board2Pon1.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board2Pon1.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board2Pon1.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board2Pon1.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board2Pon1.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board2Pon1.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board2Pon1.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board2Pon1.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board2Pon1.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board2Pon1.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board2Pon1.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board2Pon1.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board2Pon1.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board2Pon1.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board2Pon1.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board2Pon1.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board2Pon1.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board2Pon1.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board2Pon1.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board2Pon1.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board2Pon1.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board2Pon1.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board2Pon1.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board2Pon1.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board2Pon1.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board2Pon1.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board2Pon1.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board2Pon1.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board2Pon1.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board2Pon1.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board2Pon1.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board2Pon1.OnuBiasCurrentOID,
 		}
 	case 2: // PON 2
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board2Pon2.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board2Pon2.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board2Pon2.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board2Pon2.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board2Pon2.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board2Pon2.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board2Pon2.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board2Pon2.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board2Pon2.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board2Pon2.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board2Pon2.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board2Pon2.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board2Pon2.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board2Pon2.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board2Pon2.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board2Pon2.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board2Pon2.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board2Pon2.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board2Pon2.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board2Pon2.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board2Pon2.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board2Pon2.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board2Pon2.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board2Pon2.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board2Pon2.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board2Pon2.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board2Pon2.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board2Pon2.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board2Pon2.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board2Pon2.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board2Pon2.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board2Pon2.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board2Pon2.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board2Pon2.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board2Pon2.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board2Pon2.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board2Pon2.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board2Pon2.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board2Pon2.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board2Pon2.OnuBiasCurrentOID,
 		}
 	case 3: // PON 3
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board2Pon3.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board2Pon3.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board2Pon3.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board2Pon3.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board2Pon3.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board2Pon3.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board2Pon3.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board2Pon3.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board2Pon3.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board2Pon3.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board2Pon3.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board2Pon3.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board2Pon3.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board2Pon3.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board2Pon3.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board2Pon3.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board2Pon3.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board2Pon3.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board2Pon3.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board2Pon3.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board2Pon3.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board2Pon3.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board2Pon3.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board2Pon3.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board2Pon3.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board2Pon3.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board2Pon3.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board2Pon3.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board2Pon3.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board2Pon3.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board2Pon3.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board2Pon3.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board2Pon3.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board2Pon3.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board2Pon3.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board2Pon3.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board2Pon3.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board2Pon3.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board2Pon3.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board2Pon3.OnuBiasCurrentOID,
 		}
 	case 4: // PON 4
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board2Pon4.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board2Pon4.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board2Pon4.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board2Pon4.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board2Pon4.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board2Pon4.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board2Pon4.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board2Pon4.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board2Pon4.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board2Pon4.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board2Pon4.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board2Pon4.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board2Pon4.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board2Pon4.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board2Pon4.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board2Pon4.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board2Pon4.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board2Pon4.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board2Pon4.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board2Pon4.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board2Pon4.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board2Pon4.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board2Pon4.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board2Pon4.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board2Pon4.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board2Pon4.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board2Pon4.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board2Pon4.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board2Pon4.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board2Pon4.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board2Pon4.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board2Pon4.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board2Pon4.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board2Pon4.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board2Pon4.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board2Pon4.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board2Pon4.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board2Pon4.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board2Pon4.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board2Pon4.OnuBiasCurrentOID,
 		}
 	case 5: // PON 5
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board2Pon5.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board2Pon5.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board2Pon5.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board2Pon5.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board2Pon5.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board2Pon5.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board2Pon5.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board2Pon5.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board2Pon5.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board2Pon5.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board2Pon5.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board2Pon5.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board2Pon5.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board2Pon5.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board2Pon5.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board2Pon5.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board2Pon5.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board2Pon5.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board2Pon5.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board2Pon5.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board2Pon5.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board2Pon5.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board2Pon5.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board2Pon5.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board2Pon5.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board2Pon5.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board2Pon5.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board2Pon5.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board2Pon5.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board2Pon5.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board2Pon5.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board2Pon5.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board2Pon5.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board2Pon5.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board2Pon5.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board2Pon5.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board2Pon5.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board2Pon5.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board2Pon5.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board2Pon5.OnuBiasCurrentOID,
 		}
 	case 6: // PON 6
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board2Pon6.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board2Pon6.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board2Pon6.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board2Pon6.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board2Pon6.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board2Pon6.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board2Pon6.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board2Pon6.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board2Pon6.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board2Pon6.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board2Pon6.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board2Pon6.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board2Pon6.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board2Pon6.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board2Pon6.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board2Pon6.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board2Pon6.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board2Pon6.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board2Pon6.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board2Pon6.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board2Pon6.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board2Pon6.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board2Pon6.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board2Pon6.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board2Pon6.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board2Pon6.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board2Pon6.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board2Pon6.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board2Pon6.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board2Pon6.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board2Pon6.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board2Pon6.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board2Pon6.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board2Pon6.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board2Pon6.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board2Pon6.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board2Pon6.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board2Pon6.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board2Pon6.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board2Pon6.OnuBiasCurrentOID,
 		}
 	case 7: // PON 7
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board2Pon7.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board2Pon7.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board2Pon7.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board2Pon7.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board2Pon7.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board2Pon7.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board2Pon7.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board2Pon7.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board2Pon7.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board2Pon7.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board2Pon7.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board2Pon7.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board2Pon7.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board2Pon7.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board2Pon7.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board2Pon7.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board2Pon7.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board2Pon7.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board2Pon7.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board2Pon7.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board2Pon7.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board2Pon7.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board2Pon7.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board2Pon7.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board2Pon7.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board2Pon7.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board2Pon7.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board2Pon7.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board2Pon7.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board2Pon7.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board2Pon7.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board2Pon7.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board2Pon7.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board2Pon7.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board2Pon7.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board2Pon7.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board2Pon7.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board2Pon7.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board2Pon7.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board2Pon7.OnuBiasCurrentOID,
 		}
 	case 8: // PON 8
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board2Pon8.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board2Pon8.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board2Pon8.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board2Pon8.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board2Pon8.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board2Pon8.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board2Pon8.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board2Pon8.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board2Pon8.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board2Pon8.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board2Pon8.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board2Pon8.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board2Pon8.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board2Pon8.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board2Pon8.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board2Pon8.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board2Pon8.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board2Pon8.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board2Pon8.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board2Pon8.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board2Pon8.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board2Pon8.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board2Pon8.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board2Pon8.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board2Pon8.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board2Pon8.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board2Pon8.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board2Pon8.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board2Pon8.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board2Pon8.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board2Pon8.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board2Pon8.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board2Pon8.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board2Pon8.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board2Pon8.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board2Pon8.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board2Pon8.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board2Pon8.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board2Pon8.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board2Pon8.OnuBiasCurrentOID,
 		}
 	case 9: // PON 9
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board2Pon9.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board2Pon9.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board2Pon9.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board2Pon9.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board2Pon9.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board2Pon9.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board2Pon9.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board2Pon9.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board2Pon9.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board2Pon9.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board2Pon9.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board2Pon9.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board2Pon9.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board2Pon9.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board2Pon9.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board2Pon9.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board2Pon9.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board2Pon9.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board2Pon9.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board2Pon9.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board2Pon9.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board2Pon9.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board2Pon9.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board2Pon9.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board2Pon9.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board2Pon9.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board2Pon9.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board2Pon9.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board2Pon9.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board2Pon9.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board2Pon9.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board2Pon9.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board2Pon9.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board2Pon9.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board2Pon9.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board2Pon9.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board2Pon9.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board2Pon9.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board2Pon9.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board2Pon9.OnuBiasCurrentOID,
 		}
 	case 10: // PON 10
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board2Pon10.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board2Pon10.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board2Pon10.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board2Pon10.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board2Pon10.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board2Pon10.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board2Pon10.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board2Pon10.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board2Pon10.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board2Pon10.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board2Pon10.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board2Pon10.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board2Pon10.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board2Pon10.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board2Pon10.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board2Pon10.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board2Pon10.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board2Pon10.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board2Pon10.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board2Pon10.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board2Pon10.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board2Pon10.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board2Pon10.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board2Pon10.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board2Pon10.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board2Pon10.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board2Pon10.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board2Pon10.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board2Pon10.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board2Pon10.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board2Pon10.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board2Pon10.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board2Pon10.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board2Pon10.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board2Pon10.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board2Pon10.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board2Pon10.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board2Pon10.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board2Pon10.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board2Pon10.OnuBiasCurrentOID,
 		}
 	case 11: // PON 11
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board2Pon11.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board2Pon11.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board2Pon11.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board2Pon11.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board2Pon11.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board2Pon11.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board2Pon11.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board2Pon11.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board2Pon11.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board2Pon11.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board2Pon11.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board2Pon11.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board2Pon11.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board2Pon11.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board2Pon11.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board2Pon11.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board2Pon11.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board2Pon11.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board2Pon11.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board2Pon11.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board2Pon11.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board2Pon11.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board2Pon11.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board2Pon11.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board2Pon11.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board2Pon11.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board2Pon11.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board2Pon11.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board2Pon11.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board2Pon11.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board2Pon11.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board2Pon11.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board2Pon11.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board2Pon11.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board2Pon11.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board2Pon11.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board2Pon11.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board2Pon11.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board2Pon11.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board2Pon11.OnuBiasCurrentOID,
 		}
 
 	case 12: // PON 12
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board2Pon12.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board2Pon12.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board2Pon12.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board2Pon12.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board2Pon12.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board2Pon12.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board2Pon12.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board2Pon12.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board2Pon12.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board2Pon12.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board2Pon12.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board2Pon12.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board2Pon12.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board2Pon12.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board2Pon12.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board2Pon12.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board2Pon12.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board2Pon12.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board2Pon12.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board2Pon12.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board2Pon12.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board2Pon12.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board2Pon12.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board2Pon12.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board2Pon12.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board2Pon12.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board2Pon12.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board2Pon12.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board2Pon12.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board2Pon12.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board2Pon12.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board2Pon12.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board2Pon12.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board2Pon12.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board2Pon12.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board2Pon12.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board2Pon12.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board2Pon12.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board2Pon12.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board2Pon12.OnuBiasCurrentOID,
 		}
 
 	case 13: // PON 13
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board2Pon13.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board2Pon13.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board2Pon13.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board2Pon13.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board2Pon13.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board2Pon13.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board2Pon13.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board2Pon13.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board2Pon13.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board2Pon13.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board2Pon13.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board2Pon13.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board2Pon13.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board2Pon13.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board2Pon13.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board2Pon13.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board2Pon13.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board2Pon13.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board2Pon13.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board2Pon13.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board2Pon13.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board2Pon13.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board2Pon13.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board2Pon13.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board2Pon13.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board2Pon13.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board2Pon13.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board2Pon13.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board2Pon13.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board2Pon13.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board2Pon13.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board2Pon13.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board2Pon13.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board2Pon13.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board2Pon13.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board2Pon13.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board2Pon13.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board2Pon13.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board2Pon13.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board2Pon13.OnuBiasCurrentOID,
 		}
 	case 14: // PON 14
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board2Pon14.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board2Pon14.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board2Pon14.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board2Pon14.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board2Pon14.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board2Pon14.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board2Pon14.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board2Pon14.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board2Pon14.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board2Pon14.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board2Pon14.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board2Pon14.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board2Pon14.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board2Pon14.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board2Pon14.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board2Pon14.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board2Pon14.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board2Pon14.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board2Pon14.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board2Pon14.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board2Pon14.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board2Pon14.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board2Pon14.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board2Pon14.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board2Pon14.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board2Pon14.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board2Pon14.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board2Pon14.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board2Pon14.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board2Pon14.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board2Pon14.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board2Pon14.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board2Pon14.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board2Pon14.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board2Pon14.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board2Pon14.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board2Pon14.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board2Pon14.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board2Pon14.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board2Pon14.OnuBiasCurrentOID,
 		}
 	case 15: // PON 15
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board2Pon15.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board2Pon15.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board2Pon15.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board2Pon15.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board2Pon15.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board2Pon15.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board2Pon15.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board2Pon15.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board2Pon15.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board2Pon15.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board2Pon15.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board2Pon15.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board2Pon15.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board2Pon15.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board2Pon15.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board2Pon15.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board2Pon15.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board2Pon15.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board2Pon15.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board2Pon15.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board2Pon15.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board2Pon15.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board2Pon15.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board2Pon15.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board2Pon15.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board2Pon15.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board2Pon15.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board2Pon15.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board2Pon15.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board2Pon15.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board2Pon15.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board2Pon15.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board2Pon15.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board2Pon15.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board2Pon15.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board2Pon15.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board2Pon15.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board2Pon15.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board2Pon15.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board2Pon15.OnuBiasCurrentOID,
 		}
 	case 16: // PON 16
 		return &model.OltConfig{
-			BaseOID:                   u.cfg.OltCfg.BaseOID1,
-			OnuIDNameOID:              u.cfg.Board2Pon16.OnuIDNameOID,
-			OnuTypeOID:                u.cfg.Board2Pon16.OnuTypeOID,
-			OnuSerialNumberOID:        u.cfg.Board2Pon16.OnuSerialNumberOID,
-			OnuRxPowerOID:             u.cfg.Board2Pon16.OnuRxPowerOID,
-			OnuTxPowerOID:             u.cfg.Board2Pon16.OnuTxPowerOID,
-			OnuStatusOID:              u.cfg.Board2Pon16.OnuStatusOID,
-			OnuIPAddressOID:           u.cfg.Board2Pon16.OnuIPAddressOID,
-			OnuDescriptionOID:         u.cfg.Board2Pon16.OnuDescriptionOID,
-			OnuLastOnlineOID:          u.cfg.Board2Pon16.OnuLastOnlineOID,
-			OnuLastOfflineOID:         u.cfg.Board2Pon16.OnuLastOfflineOID,
-			OnuLastOfflineReasonOID:   u.cfg.Board2Pon16.OnuLastOfflineReasonOID,
-			OnuGponOpticalDistanceOID: u.cfg.Board2Pon16.OnuGponOpticalDistanceOID,
+			BaseOID:                      u.cfg.OltCfg.BaseOID1,
+			OnuIDNameOID:                 u.cfg.Board2Pon16.OnuIDNameOID,
+			OnuTypeOID:                   u.cfg.Board2Pon16.OnuTypeOID,
+			OnuSerialNumberOID:           u.cfg.Board2Pon16.OnuSerialNumberOID,
+			OnuRxPowerOID:                u.cfg.Board2Pon16.OnuRxPowerOID,
+			OnuTxPowerOID:                u.cfg.Board2Pon16.OnuTxPowerOID,
+			OnuStatusOID:                 u.cfg.Board2Pon16.OnuStatusOID,
+			OnuIPAddressOID:              u.cfg.Board2Pon16.OnuIPAddressOID,
+			OnuDescriptionOID:            u.cfg.Board2Pon16.OnuDescriptionOID,
+			OnuLastOnlineOID:             u.cfg.Board2Pon16.OnuLastOnlineOID,
+			OnuLastOfflineOID:            u.cfg.Board2Pon16.OnuLastOfflineOID,
+			OnuLastOfflineReasonOID:      u.cfg.Board2Pon16.OnuLastOfflineReasonOID,
+			OnuGponOpticalDistanceOID:    u.cfg.Board2Pon16.OnuGponOpticalDistanceOID,
+			OnuUniAdminStateOID:          u.cfg.Board2Pon16.OnuUniAdminStateOID,
+			OnuUniLinkStateOID:           u.cfg.Board2Pon16.OnuUniLinkStateOID,
+			OnuUniSpeedOID:               u.cfg.Board2Pon16.OnuUniSpeedOID,
+			OnuUniDuplexOID:              u.cfg.Board2Pon16.OnuUniDuplexOID,
+			OnuHardwareVersionOID:        u.cfg.Board2Pon16.OnuHardwareVersionOID,
+			OnuSoftwareVersionActiveOID:  u.cfg.Board2Pon16.OnuSoftwareVersionActiveOID,
+			OnuSoftwareVersionStandbyOID: u.cfg.Board2Pon16.OnuSoftwareVersionStandbyOID,
+			OnuEquipmentIDOID:            u.cfg.Board2Pon16.OnuEquipmentIDOID,
+			OnuUpstreamBytesOID:          u.cfg.Board2Pon16.OnuUpstreamBytesOID,
+			OnuDownstreamBytesOID:        u.cfg.Board2Pon16.OnuDownstreamBytesOID,
+			OnuUpstreamPacketsOID:        u.cfg.Board2Pon16.OnuUpstreamPacketsOID,
+			OnuDownstreamPacketsOID:      u.cfg.Board2Pon16.OnuDownstreamPacketsOID,
+			OnuOltRxPowerOID:             u.cfg.Board2Pon16.OnuOltRxPowerOID,
+			OnuTemperatureOID:            u.cfg.Board2Pon16.OnuTemperatureOID,
+			OnuVoltageOID:                u.cfg.Board2Pon16.OnuVoltageOID,
+			OnuBiasCurrentOID:            u.cfg.Board2Pon16.OnuBiasCurrentOID,
 		}
 	default:
 		log.Error().Msg("Invalid PON ID") // Log error message
@@ -614,7 +1187,7 @@ func (u *onuUsecase) GetByBoardIDAndPonID(ctx context.Context, boardID, ponID in
 	key := fmt.Sprintf("onuinfo-b%d-p%d", boardID, ponID)
 
 	// Using simple flight to prevent duplicate SNMP requests
-	result, err, _ := u.sg.Do(key, func() (interface{}, error) {
+	result, err := u.doShared("onu_list", key, func() (interface{}, error) {
 		// Get OLT config
 		oltConfig, err := u.getOltConfig(boardID, ponID) // Get OLT config based on Board ID and PON ID
 		if err != nil {
@@ -632,194 +1205,635 @@ func (u *onuUsecase) GetByBoardIDAndPonID(ctx context.Context, boardID, ponID in
 			return cachedOnuData, nil
 		}
 
-		// SNMP Walk to get Information from OLT Board and PON
-		log.Info().Msg("Get All ONU Information from SNMP Walk Board ID: " + strconv.Itoa(boardID) + " and PON ID: " + strconv.Itoa(ponID))
-		// Create a map to store SNMP Walk results
-		snmpDataMap := make(map[string]gosnmp.SnmpPDU)
-		// Perform SNMP Walk to get ONU ID and Name using snmpRepository Walk method with timeout context parameter
-		err = u.snmpRepository.Walk(oltConfig.BaseOID+oltConfig.OnuIDNameOID, func(pdu gosnmp.SnmpPDU) error {
-			snmpDataMap[utils.ExtractONUID(pdu.Name)] = pdu
+		return u.readOnuList(ctx, boardID, ponID, oltConfig)
+	})
+
+	if err != nil {
+		log.Error().Msg("Failed to get ONU Information: " + err.Error()) // Log error message to logger
+		return nil, err                                                  // Return error if error is not nil
+	}
+
+	return result.([]model.ONUInfoPerBoard), nil // Return the result from the cache or SNMP Walk
+}
+
+// GetOnuStatusList returns the ONU list of a PON with the status and rx power walked from the OLT on every call,
+// one walk per column. The other fields come from the cached list, it is read again from the OLT when the
+// registered ONUs differ from it.
+func (u *onuUsecase) GetOnuStatusList(ctx context.Context, boardID, ponID int) ([]model.ONUInfoPerBoard, error) {
+	oltConfig, err := u.getOltConfig(boardID, ponID) // Get OLT config based on Board ID and PON ID
+	if err != nil {
+		log.Error().Msg("Failed to get OLT Config: " + err.Error())
+		return nil, err
+	}
+
+	statuses := make(map[int]string)
+	err = u.snmpRepository.Walk(ctx, u.cfg.OltCfg.BaseOID1+oltConfig.OnuStatusOID, func(pdu gosnmp.SnmpPDU) error {
+		statuses[utils.ExtractIDOnuID(pdu.Name)] = utils.ExtractAndGetStatus(pdu.Value)
+		return nil
+	})
+	if err != nil {
+		log.Error().Msg("Failed to walk ONU status: " + err.Error())
+		return nil, err
+	}
+
+	// The rx power column is indexed by ONU ID and a trailing .1
+	rxPowers := make(map[int]string)
+	err = u.snmpRepository.Walk(ctx, u.cfg.OltCfg.BaseOID1+oltConfig.OnuRxPowerOID, func(pdu gosnmp.SnmpPDU) error {
+		parts := strings.Split(pdu.Name, ".")
+		if len(parts) < 2 || parts[len(parts)-1] != "1" {
 			return nil
-		})
+		}
+		if onuID, err := strconv.Atoi(parts[len(parts)-2]); err == nil {
+			rxPowers[onuID], _ = utils.ConvertAndMultiply(pdu.Value)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Msg("Failed to walk ONU rx power: " + err.Error())
+		return nil, err
+	}
+
+	onuInfoList, err := u.GetByBoardIDAndPonID(ctx, boardID, ponID)
+	if err != nil {
+		return nil, err
+	}
 
+	// An ONU registered or removed since the list was cached, read the list again
+	if !sameOnuIDs(onuInfoList, statuses) {
+		key := fmt.Sprintf("onuinfo-refresh-b%d-p%d", boardID, ponID)
+		result, err := u.doShared("onu_list_refresh", key, func() (interface{}, error) {
+			return u.readOnuList(ctx, boardID, ponID, oltConfig)
+		})
 		if err != nil {
+			log.Error().Msg("Failed to get ONU Information: " + err.Error())
 			return nil, err
 		}
+		onuInfoList = result.([]model.ONUInfoPerBoard)
+	}
 
-		var onuInformationList []model.ONUInfoPerBoard // Create a slice of ONUInfoPerBoard
-
-		// Loop through SNMP data map to get ONU information based on ONU ID and ONU Name stored in map before and store
-		for _, pdu := range snmpDataMap {
-			onuInfo := model.ONUInfoPerBoard{
-				Board: boardID,
-				PON:   ponID,
-				ID:    utils.ExtractIDOnuID(pdu.Name),
-				Name:  utils.ExtractName(pdu.Value),
-			}
+	// The cached list is shared, the fresh readings go into a copy
+	onuStatusList := make([]model.ONUInfoPerBoard, len(onuInfoList))
+	for i, onuInfo := range onuInfoList {
+		if status, ok := statuses[onuInfo.ID]; ok {
+			onuInfo.Status = status
+		}
+		if rxPower, ok := rxPowers[onuInfo.ID]; ok {
+			onuInfo.RXPower = rxPower
+		}
+		onuStatusList[i] = onuInfo
+	}
 
-			// Get Data ONU Type from SNMP Walk using getONUType method
-			if onuType, err := u.getONUType(oltConfig.OnuTypeOID, strconv.Itoa(onuInfo.ID)); err == nil {
-				onuInfo.OnuType = onuType
-			}
-			// Get Data ONU Serial Number from SNMP Walk using getSerialNumber method
-			if sn, err := u.getSerialNumber(oltConfig.OnuSerialNumberOID, strconv.Itoa(onuInfo.ID)); err == nil {
-				onuInfo.SerialNumber = sn
-			}
-			// Get Data ONU RX Power from SNMP Walk using getRxPower method
-			if rx, err := u.getRxPower(oltConfig.OnuRxPowerOID, strconv.Itoa(onuInfo.ID)); err == nil {
-				onuInfo.RXPower = rx
-			}
-			// Get Data ONU TX Power from SNMP Walk using getTxPower method
-			if status, err := u.getStatus(oltConfig.OnuStatusOID, strconv.Itoa(onuInfo.ID)); err == nil {
-				onuInfo.Status = status
-			}
+	return onuStatusList, nil
+}
 
-			// Get Data ONU IP Address from SNMP Walk using getIPAddress method
-			onuInformationList = append(onuInformationList, onuInfo)
+// sameOnuIDs returns true when the ONU list has exactly the ONU IDs of the walked status column
+func sameOnuIDs(onuInfoList []model.ONUInfoPerBoard, statuses map[int]string) bool {
+	if len(onuInfoList) != len(statuses) {
+		return false
+	}
+	for _, onuInfo := range onuInfoList {
+		if _, ok := statuses[onuInfo.ID]; !ok {
+			return false
 		}
+	}
+	return true
+}
 
-		// Sort the ONU information list by ID
-		sort.Slice(onuInformationList, func(i, j int) bool {
-			return onuInformationList[i].ID < onuInformationList[j].ID
-		})
+// readOnuList reads the ONU list of a PON from the OLT and saves it to the Redis cache
+func (u *onuUsecase) readOnuList(ctx context.Context, boardID, ponID int, oltConfig *model.OltConfig) (
+	[]model.ONUInfoPerBoard, error,
+) {
+	// SNMP Walk to get Information from OLT Board and PON
+	log.Info().Msg("Get All ONU Information from SNMP Walk Board ID: " + strconv.Itoa(boardID) + " and PON ID: " + strconv.Itoa(ponID))
+	// Create a map to store SNMP Walk results
+	snmpDataMap := make(map[string]gosnmp.SnmpPDU)
+	// Perform SNMP Walk to get ONU ID and Name using snmpRepository Walk method with timeout context parameter
+	err := u.snmpRepository.Walk(ctx, oltConfig.BaseOID+oltConfig.OnuIDNameOID, func(pdu gosnmp.SnmpPDU) error {
+		snmpDataMap[utils.ExtractONUID(pdu.Name)] = pdu
+		return nil
+	})
 
-		// Save the ONU information list to Redis with a 5-minute expiration time
-		err = u.redisRepository.SaveONUInfoList(ctx, redisKey, 300, onuInformationList)
-		if err != nil {
-			log.Error().Msg("Failed to save ONU Information to Redis: " + err.Error())
-		} else {
-			log.Info().Msg("Saved ONU Information to Redis with Key: " + redisKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var onuInformationList []model.ONUInfoPerBoard // Create a slice of ONUInfoPerBoard
+
+	// Loop through SNMP data map to get ONU information based on ONU ID and ONU Name stored in map before and store
+	for _, pdu := range snmpDataMap {
+		onuInfo := model.ONUInfoPerBoard{
+			Board: boardID,
+			PON:   ponID,
+			ID:    utils.ExtractIDOnuID(pdu.Name),
+			Name:  utils.ExtractName(pdu.Value),
 		}
 
-		// Return the ONU information list
-		return onuInformationList, nil
+		// Get Data ONU Type from SNMP Walk using getONUType method
+		if onuType, err := u.getONUType(ctx, oltConfig.OnuTypeOID, strconv.Itoa(onuInfo.ID)); err == nil {
+			onuInfo.OnuType = onuType
+		}
+		// Get Data ONU Serial Number from SNMP Walk using getSerialNumber method
+		if sn, err := u.getSerialNumber(ctx, oltConfig.OnuSerialNumberOID, strconv.Itoa(onuInfo.ID)); err == nil {
+			onuInfo.SerialNumber = sn
+		}
+		// Get Data ONU RX Power from SNMP Walk using getRxPower method
+		if rx, err := u.getRxPower(ctx, oltConfig.OnuRxPowerOID, strconv.Itoa(onuInfo.ID)); err == nil {
+			onuInfo.RXPower = rx
+		}
+		// Get Data OLT-measured upstream RX Power from SNMP Walk using getOltRxPower method
+		if oltRx, err := u.getOltRxPower(ctx, oltConfig.OnuOltRxPowerOID, strconv.Itoa(onuInfo.ID)); err == nil {
+			onuInfo.OltRXPower = oltRx
+		}
+		// Get Data ONU TX Power from SNMP Walk using getTxPower method
+		if status, err := u.getStatus(ctx, oltConfig.OnuStatusOID, strconv.Itoa(onuInfo.ID)); err == nil {
+			onuInfo.Status = status
+		}
+
+		// Get Data ONU IP Address from SNMP Walk using getIPAddress method
+		onuInformationList = append(onuInformationList, onuInfo)
+	}
+
+	// Sort the ONU information list by ID
+	sort.Slice(onuInformationList, func(i, j int) bool {
+		return onuInformationList[i].ID < onuInformationList[j].ID
 	})
 
+	// Save the ONU information list to Redis with a 5-minute expiration time
+	redisKey := fmt.Sprintf("board_%d_pon_%d", boardID, ponID)
+	err = u.redisRepository.SaveONUInfoList(ctx, redisKey, 300, onuInformationList)
 	if err != nil {
-		log.Error().Msg("Failed to get ONU Information: " + err.Error()) // Log error message to logger
-		return nil, err                                                  // Return error if error is not nil
+		log.Error().Msg("Failed to save ONU Information to Redis: " + err.Error())
+	} else {
+		log.Info().Msg("Saved ONU Information to Redis with Key: " + redisKey)
 	}
 
-	return result.([]model.ONUInfoPerBoard), nil // Return the result from the cache or SNMP Walk
+	return onuInformationList, nil
+}
+
+// GetByBoardIDAndPonIDWithFilter filters and sorts the ONU list of a PON. The list comes from the same Redis
+// cache as GetByBoardIDAndPonID, so filtering does not cause extra SNMP requests.
+func (u *onuUsecase) GetByBoardIDAndPonIDWithFilter(
+	ctx context.Context, boardID, ponID int, filter model.OnuListFilter,
+) ([]model.ONUInfoPerBoard, error) {
+	onuInformationList, err := u.GetByBoardIDAndPonID(ctx, boardID, ponID)
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.FilterOnuList(onuInformationList, filter), nil
 }
 
-func (u *onuUsecase) GetByBoardIDPonIDAndOnuID(boardID, ponID, onuID int) (
+// GetAllWithFilter filters and sorts the ONU list of every PON on every board. A PON that fails is logged
+// and skipped, so one unreachable PON does not hide the others.
+func (u *onuUsecase) GetAllWithFilter(ctx context.Context, filter model.OnuListFilter) (
+	[]model.ONUInfoPerBoard, error,
+) {
+	log.Info().Msg("Get All ONU Information from all Boards and PONs")
+
+	var onuInformationList []model.ONUInfoPerBoard
+	var failedPons int
+	for boardID := minBoardID; boardID <= maxBoardID; boardID++ {
+		for ponID := minPonID; ponID <= maxPonID; ponID++ {
+			ponOnuList, err := u.GetByBoardIDAndPonID(ctx, boardID, ponID)
+			if err != nil {
+				failedPons++
+				log.Error().Msg("Failed to get ONU Information from Board ID: " + strconv.Itoa(boardID) +
+					" and PON ID: " + strconv.Itoa(ponID) + ": " + err.Error())
+				continue
+			}
+			onuInformationList = append(onuInformationList, ponOnuList...)
+		}
+	}
+
+	// Fail only when no PON could be read at all
+	if failedPons == (maxBoardID-minBoardID+1)*(maxPonID-minPonID+1) {
+		return nil, errors.New("failed to get ONU information from every PON")
+	}
+
+	// Without a sort field the list keeps the board, PON, ONU ID order
+	return utils.FilterOnuList(onuInformationList, filter), nil
+}
+
+// ExportOnuInventory calls fn with every ONU matching the filter, PON by PON. A boardID or ponID of 0 means every
+// board or PON. The ONUs are read from the cached PON lists, only with detail the detail of every ONU is read from
+// the OLT as well, and when the detail fails the row is filled from the cached list. It stops when ctx is done or
+// fn fails.
+func (u *onuUsecase) ExportOnuInventory(
+	ctx context.Context, boardID, ponID int, filter model.OnuListFilter, detail bool,
+	fn func(model.ONUCustomerInfo) error,
+) error {
+	log.Info().Msg("Export ONU Inventory from Board ID: " + strconv.Itoa(boardID) + " and PON ID: " + strconv.Itoa(ponID))
+
+	// The export keeps its own traffic readings, it does not move the rate interval of the API
+	detailCtx := WithTrafficConsumer(ctx, trafficConsumerExport)
+
+	var ponCount, failedPons int
+	for currentBoardID := minBoardID; currentBoardID <= maxBoardID; currentBoardID++ {
+		if boardID != 0 && currentBoardID != boardID {
+			continue
+		}
+
+		for currentPonID := minPonID; currentPonID <= maxPonID; currentPonID++ {
+			if ponID != 0 && currentPonID != ponID {
+				continue
+			}
+
+			ponCount++
+			onuInformationList, err := u.GetByBoardIDAndPonIDWithFilter(ctx, currentBoardID, currentPonID, filter)
+			if err != nil {
+				failedPons++
+				log.Error().Msg("Failed to get ONU Information from Board ID: " + strconv.Itoa(currentBoardID) +
+					" and PON ID: " + strconv.Itoa(currentPonID) + ": " + err.Error())
+				continue
+			}
+
+			for _, onuInfo := range onuInformationList {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+
+				var onuDetail model.ONUCustomerInfo
+				if detail {
+					onuDetail, err = u.GetByBoardIDPonIDAndOnuID(detailCtx, onuInfo.Board, onuInfo.PON, onuInfo.ID)
+				}
+				if !detail || err != nil || onuDetail.ID == 0 {
+					onuDetail = model.ONUCustomerInfo{
+						Board:        onuInfo.Board,
+						PON:          onuInfo.PON,
+						ID:           onuInfo.ID,
+						Name:         onuInfo.Name,
+						OnuType:      onuInfo.OnuType,
+						SerialNumber: onuInfo.SerialNumber,
+						RXPower:      onuInfo.RXPower,
+						OltRXPower:   onuInfo.OltRXPower,
+						Status:       onuInfo.Status,
+					}
+				}
+
+				if err := fn(onuDetail); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	// Fail only when no PON could be read at all
+	if ponCount > 0 && failedPons == ponCount {
+		return errors.New("failed to get ONU information from every PON")
+	}
+
+	return nil
+}
+
+func (u *onuUsecase) GetByBoardIDPonIDAndOnuID(ctx context.Context, boardID, ponID, onuID int) (
 	model.ONUCustomerInfo, error,
 ) {
+	// Get every detail field
+	return u.GetByBoardIDPonIDAndOnuIDWithFields(ctx, boardID, ponID, onuID, nil)
+}
+
+func (u *onuUsecase) GetOnuUniPorts(ctx context.Context, boardID, ponID, onuID int) ([]model.ONUUniPort, error) {
 	// Set key for simple flight
-	key := fmt.Sprintf("onu:%d:%d:%d", boardID, ponID, onuID)
+	key := fmt.Sprintf("onu_uni:%d:%d:%d", boardID, ponID, onuID)
 
 	// Using simple flight to prevent duplicate SNMP requests
-	result, err, _ := u.sg.Do(key, func() (interface{}, error) {
+	result, err := u.doShared("uni_ports", key, func() (interface{}, error) {
 		oltConfig, err := u.getOltConfig(boardID, ponID) // Get OLT config based on Board ID and PON ID
 		if err != nil {
 			log.Error().Msg("Failed to get OLT Config: " + err.Error())
-			return model.ONUCustomerInfo{}, err
+			return nil, err
 		}
 
-		var onuInformationList model.ONUCustomerInfo   // Create a variable to store ONU information
-		snmpDataMap := make(map[string]gosnmp.SnmpPDU) // Create a map to store SNMP Walk results
-
-		log.Info().Msg("Get Detail ONU Information with SNMP Walk from Board ID: " +
+		log.Info().Msg("Get ONU UNI Port Status with SNMP Walk from Board ID: " +
 			strconv.Itoa(boardID) + " PON ID: " + strconv.Itoa(ponID) +
 			" ONU ID: " + strconv.Itoa(onuID))
 
-		// Get ONU ID and Name using snmpRepository Walk method with timeout context parameter
-		err = u.snmpRepository.Walk(oltConfig.BaseOID+oltConfig.OnuIDNameOID+"."+strconv.Itoa(onuID),
-			func(pdu gosnmp.SnmpPDU) error {
-				snmpDataMap[utils.ExtractONUID(pdu.Name)] = pdu
-				return nil
-			})
+		// The link state column is walked first because it defines which UNI ports the ONU exposes
+		linkStates, err := u.walkOnuUniColumn(ctx, oltConfig.OnuUniLinkStateOID, strconv.Itoa(onuID))
 		if err != nil {
-			log.Error().Msg("Failed to walk OID: " + err.Error())
-			return model.ONUCustomerInfo{}, errors.New("failed to walk OID")
+			log.Error().Msg("Failed to walk ONU UNI link state: " + err.Error())
+			return nil, errors.New("failed to walk OID")
 		}
 
-		// Loop through SNMP data map to get ONU information based on ONU ID and ONU Name stored in map before and store
-		for _, pdu := range snmpDataMap {
-			onuInfo := model.ONUCustomerInfo{
-				Board: boardID,
-				PON:   ponID,
-				ID:    utils.ExtractIDOnuID(pdu.Name),
-				Name:  utils.ExtractName(pdu.Value),
-			}
+		// The remaining columns are optional, a failed walk only leaves the field as Unknown
+		adminStates, err := u.walkOnuUniColumn(ctx, oltConfig.OnuUniAdminStateOID, strconv.Itoa(onuID))
+		if err != nil {
+			log.Warn().Msg("Failed to walk ONU UNI admin state: " + err.Error())
+		}
+		speeds, err := u.walkOnuUniColumn(ctx, oltConfig.OnuUniSpeedOID, strconv.Itoa(onuID))
+		if err != nil {
+			log.Warn().Msg("Failed to walk ONU UNI speed: " + err.Error())
+		}
+		duplexes, err := u.walkOnuUniColumn(ctx, oltConfig.OnuUniDuplexOID, strconv.Itoa(onuID))
+		if err != nil {
+			log.Warn().Msg("Failed to walk ONU UNI duplex: " + err.Error())
+		}
 
-			// Get Data ONU Type from SNMP Walk using getONUType method
-			if onuType, err := u.getONUType(oltConfig.OnuTypeOID, strconv.Itoa(onuInfo.ID)); err == nil {
-				onuInfo.OnuType = onuType
-			}
+		uniPortList := make([]model.ONUUniPort, 0, len(linkStates))
+		for port, value := range linkStates {
+			uniPortList = append(uniPortList, model.ONUUniPort{
+				Board:      boardID,
+				PON:        ponID,
+				ID:         onuID,
+				Port:       port,
+				AdminState: utils.ExtractUniAdminState(adminStates[port]),
+				LinkState:  utils.ExtractUniLinkState(value),
+				Speed:      utils.ExtractUniSpeed(speeds[port]),
+				Duplex:     utils.ExtractUniDuplex(duplexes[port]),
+			})
+		}
 
-			// Get Data ONU Serial Number from SNMP Walk using getSerialNumber method
-			if serial, err := u.getSerialNumber(oltConfig.OnuSerialNumberOID, strconv.Itoa(onuInfo.ID)); err == nil {
-				onuInfo.SerialNumber = serial
-			}
+		// Sort UNI port list based on port number ascending
+		sort.Slice(uniPortList, func(i, j int) bool {
+			return uniPortList[i].Port < uniPortList[j].Port
+		})
 
-			// Get Data ONU RX Power from SNMP Walk using getRxPower method
-			if rx, err := u.getRxPower(oltConfig.OnuRxPowerOID, strconv.Itoa(onuInfo.ID)); err == nil {
-				onuInfo.RXPower = rx
-			}
+		return uniPortList, nil
+	})
 
-			// Get Data ONU TX Power from SNMP Walk using getTxPower method
-			if tx, err := u.getTxPower(oltConfig.OnuTxPowerOID, strconv.Itoa(onuInfo.ID)); err == nil {
-				onuInfo.TXPower = tx
-			}
+	if err != nil {
+		log.Error().Msg("Failed to get ONU UNI Ports: " + err.Error()) // Log error message to logger
+		return nil, err                                                // Return error if error is not nil
+	}
 
-			// Get Data ONU Status from SNMP Walk using getStatus method
-			if status, err := u.getStatus(oltConfig.OnuStatusOID, strconv.Itoa(onuInfo.ID)); err == nil {
-				onuInfo.Status = status
-			}
+	return result.([]model.ONUUniPort), nil
+}
 
-			// Get Data ONU IP Address from SNMP Walk using getIPAddress method
-			if ip, err := u.getIPAddress(oltConfig.OnuIPAddressOID, strconv.Itoa(onuInfo.ID)); err == nil {
-				onuInfo.IPAddress = ip
-			}
+func (u *onuUsecase) GetOnuFirmwareInventory(
+	ctx context.Context, onuType, version string,
+) ([]model.OnuFirmwareInventory, error) {
+	// Set key for simple flight
+	key := fmt.Sprintf("onu_firmware_inventory:%s:%s", onuType, version)
 
-			// Get Data ONU Description from SNMP Walk using getDescription method
-			if desc, err := u.getDescription(oltConfig.OnuDescriptionOID, strconv.Itoa(onuInfo.ID)); err == nil {
-				onuInfo.Description = desc
+	// Using simple flight to prevent duplicate requests for the same data
+	result, err := u.doShared("firmware_inventory", key, func() (interface{}, error) {
+		inventoryMap := make(map[string]*model.OnuFirmwareInventory)
+		var failedPon int
+
+		// Loop through every board and PON, a PON that fails is skipped so the inventory stays usable
+		for boardID := minBoardID; boardID <= maxBoardID; boardID++ {
+			for ponID := minPonID; ponID <= maxPonID; ponID++ {
+				onuVersionList, err := u.getOnuVersionList(ctx, boardID, ponID)
+				if err != nil {
+					log.Warn().Msg("Failed to get ONU Version List from Board ID: " + strconv.Itoa(boardID) +
+						" and PON ID: " + strconv.Itoa(ponID) + ": " + err.Error())
+					failedPon++
+					continue
+				}
+
+				for _, onuVersion := range onuVersionList {
+					// Filter by ONU type and firmware version when requested
+					if onuType != "" && onuVersion.OnuType != onuType {
+						continue
+					}
+					if version != "" && onuVersion.SoftwareVersion != version {
+						continue
+					}
+
+					groupKey := onuVersion.OnuType + "|" + onuVersion.SoftwareVersion
+					group, ok := inventoryMap[groupKey]
+					if !ok {
+						group = &model.OnuFirmwareInventory{
+							OnuType:         onuVersion.OnuType,
+							SoftwareVersion: onuVersion.SoftwareVersion,
+							Onus:            make([]model.OnuID, 0),
+						}
+						inventoryMap[groupKey] = group
+					}
+
+					group.Count++
+					group.Onus = append(group.Onus, model.OnuID{
+						Board: onuVersion.Board,
+						PON:   onuVersion.PON,
+						ID:    onuVersion.ID,
+					})
+				}
 			}
+		}
 
-			// Get Data ONU Last Online from SNMP Walk using getLastOnline method
-			if lastOnline, err := u.getLastOnline(oltConfig.OnuLastOnlineOID, strconv.Itoa(onuInfo.ID)); err == nil {
-				onuInfo.LastOnline = lastOnline
-			}
+		// If every PON failed the OLT is unreachable, so report it instead of an empty inventory
+		if failedPon == (maxBoardID-minBoardID+1)*(maxPonID-minPonID+1) {
+			return nil, errors.New("failed to get ONU version from every PON")
+		}
 
-			// Get Data ONU Last Offline from SNMP Walk using getLastOffline method
-			if lastOffline, err := u.getLastOffline(oltConfig.OnuLastOfflineOID, strconv.Itoa(onuInfo.ID)); err == nil {
-				onuInfo.LastOffline = lastOffline
-			}
+		inventoryList := make([]model.OnuFirmwareInventory, 0, len(inventoryMap))
+		for _, group := range inventoryMap {
+			inventoryList = append(inventoryList, *group)
+		}
 
-			// Get Data ONU Last Offline Reason from SNMP Walk using getLastOfflineReason method
-			if uptime, err := u.getUptimeDuration(onuInfo.LastOnline); err == nil {
-				onuInfo.Uptime = uptime
+		// Sort by ONU type then firmware version ascending
+		sort.Slice(inventoryList, func(i, j int) bool {
+			if inventoryList[i].OnuType != inventoryList[j].OnuType {
+				return inventoryList[i].OnuType < inventoryList[j].OnuType
 			}
+			return inventoryList[i].SoftwareVersion < inventoryList[j].SoftwareVersion
+		})
 
-			// Get Data ONU Last Downtime Duration from SNMP Walk using getLastDownDuration method
-			if downtime, err := u.getLastDownDuration(onuInfo.LastOffline, onuInfo.LastOnline); err == nil {
-				onuInfo.LastDownTimeDuration = downtime
-			}
+		return inventoryList, nil
+	})
+
+	if err != nil {
+		log.Error().Msg("Failed to get ONU Firmware Inventory: " + err.Error()) // Log error message to logger
+		return nil, err                                                         // Return error if error is not nil
+	}
+
+	return result.([]model.OnuFirmwareInventory), nil
+}
+
+// getOnuVersionList returns the ONU type and active firmware version of every ONU on a PON,
+// using one SNMP Walk per column and a 5-minute Redis cache
+func (u *onuUsecase) getOnuVersionList(ctx context.Context, boardID, ponID int) ([]model.OnuVersion, error) {
+	oltConfig, err := u.getOltConfig(boardID, ponID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Redis Key
+	redisKey := fmt.Sprintf("board_%d_pon_%d_onu_version", boardID, ponID)
+
+	// Try to get data from Redis using GetOnuVersionList method
+	cachedOnuData, err := u.redisRepository.GetOnuVersionList(ctx, redisKey)
+	if err == nil && cachedOnuData != nil {
+		log.Info().Msg("Get ONU Version List from Redis with Key: " + redisKey)
+		return cachedOnuData, nil
+	}
+
+	// Walk the ONU type column, it defines which ONUs are registered on the PON
+	onuTypeMap := make(map[int]string)
+	err = u.snmpRepository.Walk(ctx, u.cfg.OltCfg.BaseOID2+oltConfig.OnuTypeOID, func(pdu gosnmp.SnmpPDU) error {
+		onuTypeMap[utils.ExtractIDOnuID(pdu.Name)] = utils.ExtractName(pdu.Value)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Walk the active software version column
+	versionMap := make(map[int]string)
+	err = u.snmpRepository.Walk(ctx, u.cfg.OltCfg.BaseOID2+oltConfig.OnuSoftwareVersionActiveOID, func(pdu gosnmp.SnmpPDU) error {
+		versionMap[utils.ExtractIDOnuID(pdu.Name)] = utils.ExtractName(pdu.Value)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	onuVersionList := make([]model.OnuVersion, 0, len(onuTypeMap))
+	for onuID, onuType := range onuTypeMap {
+		onuVersionList = append(onuVersionList, model.OnuVersion{
+			Board:           boardID,
+			PON:             ponID,
+			ID:              onuID,
+			OnuType:         onuType,
+			SoftwareVersion: versionMap[onuID],
+		})
+	}
+
+	// Sort by ID ascending
+	sort.Slice(onuVersionList, func(i, j int) bool {
+		return onuVersionList[i].ID < onuVersionList[j].ID
+	})
+
+	// Save the ONU version list to Redis with a 5-minute expiration time
+	if err := u.redisRepository.SaveOnuVersionList(ctx, redisKey, 300, onuVersionList); err != nil {
+		log.Error().Msg("Failed to save ONU Version List to Redis: " + err.Error())
+	}
+
+	return onuVersionList, nil
+}
+
+func (u *onuUsecase) GetTopTalkers(
+	ctx context.Context, boardID, ponID, limit int, direction string,
+) ([]model.OnuTrafficInfo, error) {
+	// Set key for simple flight
+	key := fmt.Sprintf("top_talkers:%d:%d", boardID, ponID)
+
+	// Using simple flight to prevent duplicate requests for the same PON, limit and direction are applied afterwards
+	result, err := u.doShared("top_talkers", key, func() (interface{}, error) {
+		oltConfig, err := u.getOltConfig(boardID, ponID)
+		if err != nil {
+			log.Error().Msg("Failed to get OLT Config: " + err.Error())
+			return nil, err
+		}
+
+		log.Info().Msg("Get ONU Traffic with SNMP Walk from Board ID: " + strconv.Itoa(boardID) +
+			" and PON ID: " + strconv.Itoa(ponID))
+
+		// Walk ONU names, it defines which ONUs are registered on the PON
+		nameMap := make(map[int]string)
+		err = u.snmpRepository.Walk(ctx, oltConfig.BaseOID+oltConfig.OnuIDNameOID, func(pdu gosnmp.SnmpPDU) error {
+			nameMap[utils.ExtractIDOnuID(pdu.Name)] = utils.ExtractName(pdu.Value)
+			return nil
+		})
+		if err != nil {
+			log.Error().Msg("Failed to walk ONU names: " + err.Error())
+			return nil, err
+		}
+
+		onuIDs := make([]int, 0, len(nameMap))
+		for onuID := range nameMap {
+			onuIDs = append(onuIDs, onuID)
+		}
 
-			// Get Data ONU Last Offline Reason from SNMP Walk using getLastOfflineReason method
-			if reason, err := u.getLastOfflineReason(oltConfig.OnuLastOfflineReasonOID, strconv.Itoa(onuInfo.ID)); err == nil {
-				onuInfo.LastOfflineReason = reason
+		// Without a recent reading of the PON the counters are read twice, so the first call has rates as well
+		if !u.hasTrafficRate(trafficConsumerTopTalkers, boardID, ponID, onuIDs, time.Now()) {
+			trafficMap, err := u.walkTrafficCounters(ctx, oltConfig)
+			if err != nil {
+				return nil, err
+			}
+			now := time.Now()
+			for _, onuID := range onuIDs {
+				u.applyTrafficRate(trafficConsumerTopTalkers, boardID, ponID, onuID, trafficMap[onuID], now)
 			}
 
-			// Get Data ONU GPON Optical Distance from SNMP Walk using getOnuGponOpticalDistance method
-			if dist, err := u.getOnuGponOpticalDistance(oltConfig.OnuGponOpticalDistanceOID, strconv.Itoa(onuInfo.ID)); err == nil {
-				onuInfo.GponOpticalDistance = dist
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(u.trafficWait):
 			}
+		}
 
-			onuInformationList = onuInfo // Append ONU information to the onuInformationList
+		trafficMap, err := u.walkTrafficCounters(ctx, oltConfig)
+		if err != nil {
+			return nil, err
 		}
 
-		return onuInformationList, nil // Return the ONU information list
+		now := time.Now()
+		trafficList := make([]model.OnuTrafficInfo, 0, len(nameMap))
+		for onuID, name := range nameMap {
+			trafficList = append(trafficList, model.OnuTrafficInfo{
+				Board:   boardID,
+				PON:     ponID,
+				ID:      onuID,
+				Name:    name,
+				Traffic: u.applyTrafficRate(trafficConsumerTopTalkers, boardID, ponID, onuID, trafficMap[onuID], now),
+			})
+		}
+
+		return trafficList, nil
 	})
 
 	if err != nil {
-		return model.ONUCustomerInfo{}, err
+		log.Error().Msg("Failed to get Top Talkers: " + err.Error()) // Log error message to logger
+		return nil, err                                              // Return error if error is not nil
+	}
+
+	// Copy the shared result before sorting so concurrent callers do not race on the same slice
+	trafficList := append([]model.OnuTrafficInfo(nil), result.([]model.OnuTrafficInfo)...)
+
+	// Sort by bit rate descending for the requested direction, both directions combined by default
+	bitRate := func(traffic model.ONUTraffic) float64 {
+		switch direction {
+		case "upstream":
+			return traffic.UpstreamBitRate
+		case "downstream":
+			return traffic.DownstreamBitRate
+		default:
+			return traffic.UpstreamBitRate + traffic.DownstreamBitRate
+		}
+	}
+	sort.SliceStable(trafficList, func(i, j int) bool {
+		return bitRate(trafficList[i].Traffic) > bitRate(trafficList[j].Traffic)
+	})
+
+	if limit > 0 && limit < len(trafficList) {
+		trafficList = trafficList[:limit]
 	}
 
-	return result.(model.ONUCustomerInfo), nil // Return the result from the cache or SNMP Walk
+	return trafficList, nil
+}
+
+// walkTrafficCounters walks every traffic counter column of a PON, one walk per column, and returns the
+// counters of every ONU
+func (u *onuUsecase) walkTrafficCounters(ctx context.Context, oltConfig *model.OltConfig) (map[int]model.ONUTraffic, error) {
+	trafficMap := make(map[int]model.ONUTraffic)
+	counterColumns := []struct {
+		oid     string
+		counter func(traffic *model.ONUTraffic) *uint64
+	}{
+		{oltConfig.OnuUpstreamBytesOID, func(traffic *model.ONUTraffic) *uint64 { return &traffic.UpstreamBytes }},
+		{oltConfig.OnuDownstreamBytesOID, func(traffic *model.ONUTraffic) *uint64 { return &traffic.DownstreamBytes }},
+		{oltConfig.OnuUpstreamPacketsOID, func(traffic *model.ONUTraffic) *uint64 { return &traffic.UpstreamPackets }},
+		{oltConfig.OnuDownstreamPacketsOID, func(traffic *model.ONUTraffic) *uint64 { return &traffic.DownstreamPackets }},
+	}
+
+	for _, column := range counterColumns {
+		err := u.snmpRepository.Walk(ctx, u.cfg.OltCfg.BaseOID2+column.oid, func(pdu gosnmp.SnmpPDU) error {
+			if counter, err := utils.ConvertCounterToUint64(pdu.Value); err == nil {
+				onuID := utils.ExtractIDOnuID(pdu.Name)
+				traffic := trafficMap[onuID]
+				*column.counter(&traffic) = counter
+				trafficMap[onuID] = traffic
+			}
+			return nil
+		})
+		if err != nil {
+			log.Error().Msg("Failed to walk ONU traffic counters: " + err.Error())
+			return nil, err
+		}
+	}
+
+	return trafficMap, nil
 }
 
 func (u *onuUsecase) GetEmptyOnuID(ctx context.Context, boardID, ponID int) ([]model.OnuID, error) {
@@ -827,7 +1841,7 @@ func (u *onuUsecase) GetEmptyOnuID(ctx context.Context, boardID, ponID int) ([]m
 	key := fmt.Sprintf("empty_onu_id:%d:%d", boardID, ponID)
 
 	// Using simple flight to prevent duplicate requests for the same data
-	result, err, _ := u.sg.Do(key, func() (interface{}, error) {
+	result, err := u.doShared("empty_onu_id", key, func() (interface{}, error) {
 		// Get OLT config based on Board ID and PON ID
 		oltConfig, err := u.getOltConfig(boardID, ponID)
 		if err != nil {
@@ -853,7 +1867,7 @@ func (u *onuUsecase) GetEmptyOnuID(ctx context.Context, boardID, ponID int) ([]m
 		log.Info().Msg("Get Empty ONU ID with SNMP Walk from Board ID: " + strconv.Itoa(boardID) + " and PON ID: " + strconv.Itoa(ponID))
 
 		// Perform SNMP Walk to get ONU ID and Name
-		err = u.snmpRepository.Walk(snmpOID, func(pdu gosnmp.SnmpPDU) error {
+		err = u.snmpRepository.Walk(ctx, snmpOID, func(pdu gosnmp.SnmpPDU) error {
 			idOnuID := utils.ExtractIDOnuID(pdu.Name)
 			emptyOnuIDList = append(emptyOnuIDList, model.OnuID{
 				Board: boardID,
@@ -913,12 +1927,12 @@ func (u *onuUsecase) GetEmptyOnuID(ctx context.Context, boardID, ponID int) ([]m
 	return result.([]model.OnuID), nil
 }
 
-func (u *onuUsecase) GetOnuIDAndSerialNumber(boardID, ponID int) ([]model.OnuSerialNumber, error) {
+func (u *onuUsecase) GetOnuIDAndSerialNumber(ctx context.Context, boardID, ponID int) ([]model.OnuSerialNumber, error) {
 	// Set key for simple flight
 	key := fmt.Sprintf("onu_id_and_serial_number:%d:%d", boardID, ponID)
 
 	// Using simple flight to prevent duplicate requests for the same data
-	result, err, _ := u.sg.Do(key, func() (interface{}, error) {
+	result, err := u.doShared("onu_id_sn", key, func() (interface{}, error) {
 		// Get OLT config based on Board ID and PON ID
 		oltConfig, err := u.getOltConfig(boardID, ponID)
 		if err != nil {
@@ -933,7 +1947,7 @@ func (u *onuUsecase) GetOnuIDAndSerialNumber(boardID, ponID int) ([]model.OnuSer
 		log.Info().Msg("Get ONU ID with SNMP Walk from Board ID: " + strconv.Itoa(boardID) + " and PON ID: " + strconv.Itoa(ponID))
 
 		// Perform SNMP BulkWalk to get ONU ID and Name
-		err = u.snmpRepository.Walk(snmpOID, func(pdu gosnmp.SnmpPDU) error {
+		err = u.snmpRepository.Walk(ctx, snmpOID, func(pdu gosnmp.SnmpPDU) error {
 			idOnuID := utils.ExtractIDOnuID(pdu.Name)
 			onuIDList = append(onuIDList, model.OnuID{
 				Board: boardID,
@@ -953,7 +1967,7 @@ func (u *onuUsecase) GetOnuIDAndSerialNumber(boardID, ponID int) ([]model.OnuSer
 		// Loop through onuIDList to get ONU Serial Number
 		for _, onuInfo := range onuIDList {
 			// Get Data ONU Serial Number from SNMP Walk using getSerialNumber method
-			onuSerialNumber, err := u.getSerialNumber(oltConfig.OnuSerialNumberOID, strconv.Itoa(onuInfo.ID))
+			onuSerialNumber, err := u.getSerialNumber(ctx, oltConfig.OnuSerialNumberOID, strconv.Itoa(onuInfo.ID))
 			if err == nil {
 				onuSerialNumberList = append(onuSerialNumberList, model.OnuSerialNumber{
 					Board:        boardID,
@@ -980,12 +1994,16 @@ func (u *onuUsecase) GetOnuIDAndSerialNumber(boardID, ponID int) ([]model.OnuSer
 	return result.([]model.OnuSerialNumber), nil
 }
 
-func (u *onuUsecase) UpdateEmptyOnuID(ctx context.Context, boardID, ponID int) error {
+// UpdateEmptyOnuID refreshes the empty ONU IDs of a PON in Redis with an SNMP Walk. It returns the cached list it
+// replaced, nil when it was not cached, and the new list
+func (u *onuUsecase) UpdateEmptyOnuID(
+	ctx context.Context, boardID, ponID int,
+) (before, after []model.OnuID, err error) {
 	// Set key for simple flight
 	key := fmt.Sprintf("update_empty_onu_id:%d:%d", boardID, ponID)
 
 	// Using simple flight to prevent duplicate requests for the same data
-	_, err, _ := u.sg.Do(key, func() (interface{}, error) {
+	result, err := u.doShared("update_empty_onu_id", key, func() (interface{}, error) {
 		// Get OLT config based on Board ID and PON ID
 		oltConfig, err := u.getOltConfig(boardID, ponID)
 		if err != nil {
@@ -1000,7 +2018,7 @@ func (u *onuUsecase) UpdateEmptyOnuID(ctx context.Context, boardID, ponID int) e
 		log.Info().Msg("Get Empty ONU ID with SNMP Walk from Board ID: " + strconv.Itoa(boardID) + " and PON ID: " + strconv.Itoa(ponID))
 
 		// Perform SNMP BulkWalk to get ONU ID and Name
-		err = u.snmpRepository.Walk(snmpOID, func(pdu gosnmp.SnmpPDU) error {
+		err = u.snmpRepository.Walk(ctx, snmpOID, func(pdu gosnmp.SnmpPDU) error {
 			idOnuID := utils.ExtractIDOnuID(pdu.Name)
 			emptyOnuIDList = append(emptyOnuIDList, model.OnuID{
 				Board: boardID,
@@ -1036,8 +2054,14 @@ func (u *onuUsecase) UpdateEmptyOnuID(ctx context.Context, boardID, ponID int) e
 			return emptyOnuIDList[i].ID < emptyOnuIDList[j].ID
 		})
 
-		// Set data to Redis using SetOnuIDCtx method
+		// Get the cached data that is replaced, for the audit log
 		redisKey := "board_" + strconv.Itoa(boardID) + "_pon_" + strconv.Itoa(ponID) + "_empty_onu_id"
+		cachedOnuIDList, err := u.redisRepository.GetOnuIDCtx(ctx, redisKey)
+		if err != nil {
+			cachedOnuIDList = nil
+		}
+
+		// Set data to Redis using SetOnuIDCtx method
 		err = u.redisRepository.SetOnuIDCtx(ctx, redisKey, 300, emptyOnuIDList)
 		if err != nil {
 			log.Error().Msg("Failed to set data to Redis: " + err.Error())
@@ -1045,21 +2069,25 @@ func (u *onuUsecase) UpdateEmptyOnuID(ctx context.Context, boardID, ponID int) e
 		}
 
 		log.Info().Msg("Save Update Empty ONU ID to Redis with Key: " + redisKey)
-		return nil, nil
+		return [2][]model.OnuID{cachedOnuIDList, emptyOnuIDList}, nil
 	})
+	if err != nil {
+		return nil, nil, err
+	}
 
-	return err
+	onuIDLists := result.([2][]model.OnuID)
+	return onuIDLists[0], onuIDLists[1], nil
 }
 
 func (u *onuUsecase) GetByBoardIDAndPonIDWithPagination(
-	boardID, ponID, pageIndex, pageSize int,
+	ctx context.Context, boardID, ponID, pageIndex, pageSize int,
 ) ([]model.ONUInfoPerBoard, int) {
 
 	// Create a unique key for this request based on the parameters
 	key := fmt.Sprintf("get_onu_info:%d:%d:%d:%d", boardID, ponID, pageIndex, pageSize)
 
 	// Using simple flight to prevent duplicate requests for the same data
-	result, err, _ := u.sg.Do(key, func() (interface{}, error) {
+	result, err := u.doShared("onu_list_paginated", key, func() (interface{}, error) {
 		// Get OLT config based on Board ID and PON ID
 		oltConfig, err := u.getOltConfig(boardID, ponID)
 		if err != nil {
@@ -1074,7 +2102,7 @@ func (u *onuUsecase) GetByBoardIDAndPonIDWithPagination(
 
 		// If data does not exist in Redis, then get data from SNMP
 		if len(onlyOnuIDList) == 0 {
-			err := u.snmpRepository.Walk(snmpOID, func(pdu gosnmp.SnmpPDU) error {
+			err := u.snmpRepository.Walk(ctx, snmpOID, func(pdu gosnmp.SnmpPDU) error {
 				onlyOnuIDList = append(onlyOnuIDList, model.OnuOnlyID{
 					ID: utils.ExtractIDOnuID(pdu.Name),
 				})
@@ -1117,31 +2145,37 @@ func (u *onuUsecase) GetByBoardIDAndPonIDWithPagination(
 			}
 
 			// Get Name based on ONU ID and ONU Name OID and store it to ONU onuInfo struct
-			onuName, err := u.getName(oltConfig.OnuIDNameOID, strconv.Itoa(onuInfo.ID))
+			onuName, err := u.getName(ctx, oltConfig.OnuIDNameOID, strconv.Itoa(onuInfo.ID))
 			if err == nil {
 				onuInfo.Name = onuName // Set ONU Name to ONU onuInfo struct Name field
 			}
 
 			// Get ONU Type based on ONU ID and ONU Type OID and store it to ONU onuInfo struct
-			onuType, err := u.getONUType(oltConfig.OnuTypeOID, strconv.Itoa(onuInfo.ID))
+			onuType, err := u.getONUType(ctx, oltConfig.OnuTypeOID, strconv.Itoa(onuInfo.ID))
 			if err == nil {
 				onuInfo.OnuType = onuType // Set ONU Type to ONU onuInfo struct OnuType field
 			}
 
 			// Get ONU Serial Number based on ONU ID and ONU Serial Number OID and store it to ONU onuInfo struct
-			onuSerialNumber, err := u.getSerialNumber(oltConfig.OnuSerialNumberOID, strconv.Itoa(onuInfo.ID))
+			onuSerialNumber, err := u.getSerialNumber(ctx, oltConfig.OnuSerialNumberOID, strconv.Itoa(onuInfo.ID))
 			if err == nil {
 				onuInfo.SerialNumber = onuSerialNumber // Set ONU Serial Number to ONU onuInfo struct SerialNumber field
 			}
 
 			// Get ONU RX Power based on ONU ID and ONU RX Power OID and store it to ONU onuInfo struct
-			onuRXPower, err := u.getRxPower(oltConfig.OnuRxPowerOID, strconv.Itoa(onuInfo.ID))
+			onuRXPower, err := u.getRxPower(ctx, oltConfig.OnuRxPowerOID, strconv.Itoa(onuInfo.ID))
 			if err == nil {
 				onuInfo.RXPower = onuRXPower // Set ONU RX Power to ONU onuInfo struct RXPower field
 			}
 
+			// Get OLT-measured upstream RX Power based on ONU ID and OLT RX Power OID and store it to ONU onuInfo struct
+			oltRXPower, err := u.getOltRxPower(ctx, oltConfig.OnuOltRxPowerOID, strconv.Itoa(onuInfo.ID))
+			if err == nil {
+				onuInfo.OltRXPower = oltRXPower // Set OLT RX Power to ONU onuInfo struct OltRXPower field
+			}
+
 			// Get ONU Status based on ONU ID and ONU Status OID and store it to ONU onuInfo struct
-			onuStatus, err := u.getStatus(oltConfig.OnuStatusOID, strconv.Itoa(onuInfo.ID))
+			onuStatus, err := u.getStatus(ctx, oltConfig.OnuStatusOID, strconv.Itoa(onuInfo.ID))
 			if err == nil {
 				onuInfo.Status = onuStatus // Set ONU Status to ONU onuInfo struct Status field
 			}
@@ -1173,36 +2207,36 @@ func (u *onuUsecase) GetByBoardIDAndPonIDWithPagination(
 
 }
 
-func (u *onuUsecase) getName(OnuIDNameOID, onuID string) (string, error) {
+func (u *onuUsecase) getName(ctx context.Context, OnuIDNameOID, onuID string) (string, error) {
 	oid := u.cfg.OltCfg.BaseOID1 + OnuIDNameOID + "." + onuID
-	result, err := u.getFromSNMPWithSingleflight(oid)
+	result, err := u.getFromSNMPWithSingleflight(ctx, oid)
 	if err != nil {
 		return "", err
 	}
 	return utils.ExtractName(result.Variables[0].Value), nil
 }
 
-func (u *onuUsecase) getONUType(OnuTypeOID, onuID string) (string, error) {
+func (u *onuUsecase) getONUType(ctx context.Context, OnuTypeOID, onuID string) (string, error) {
 	oid := u.cfg.OltCfg.BaseOID2 + OnuTypeOID + "." + onuID
-	result, err := u.getFromSNMPWithSingleflight(oid)
+	result, err := u.getFromSNMPWithSingleflight(ctx, oid)
 	if err != nil {
 		return "", err
 	}
 	return utils.ExtractName(result.Variables[0].Value), nil
 }
 
-func (u *onuUsecase) getSerialNumber(OnuSerialNumberOID, onuID string) (string, error) {
+func (u *onuUsecase) getSerialNumber(ctx context.Context, OnuSerialNumberOID, onuID string) (string, error) {
 	oid := u.cfg.OltCfg.BaseOID1 + OnuSerialNumberOID + "." + onuID
-	result, err := u.getFromSNMPWithSingleflight(oid)
+	result, err := u.getFromSNMPWithSingleflight(ctx, oid)
 	if err != nil {
 		return "", err
 	}
 	return utils.ExtractSerialNumber(result.Variables[0].Value), nil
 }
 
-func (u *onuUsecase) getTxPower(OnuTxPowerOID, onuID string) (string, error) {
-	oid := u.cfg.OltCfg.BaseOID2 + OnuTxPowerOID + "." + onuID + ".1"
-	result, err := u.getFromSNMPWithSingleflight(oid)
+func (u *onuUsecase) getRxPower(ctx context.Context, OnuRxPowerOID, onuID string) (string, error) {
+	oid := u.cfg.OltCfg.BaseOID1 + OnuRxPowerOID + "." + onuID + ".1"
+	result, err := u.getFromSNMPWithSingleflight(ctx, oid)
 	if err != nil {
 		return "", err
 	}
@@ -1210,131 +2244,181 @@ func (u *onuUsecase) getTxPower(OnuTxPowerOID, onuID string) (string, error) {
 	return power, nil
 }
 
-func (u *onuUsecase) getRxPower(OnuRxPowerOID, onuID string) (string, error) {
-	oid := u.cfg.OltCfg.BaseOID1 + OnuRxPowerOID + "." + onuID + ".1"
-	result, err := u.getFromSNMPWithSingleflight(oid)
+func (u *onuUsecase) getOltRxPower(ctx context.Context, OnuOltRxPowerOID, onuID string) (string, error) {
+	oid := u.cfg.OltCfg.BaseOID1 + OnuOltRxPowerOID + "." + onuID
+	result, err := u.getFromSNMPWithSingleflight(ctx, oid)
 	if err != nil {
 		return "", err
 	}
-	power, _ := utils.ConvertAndMultiply(result.Variables[0].Value)
-	return power, nil
+	return utils.ConvertAndScale(result.Variables[0].Value, 0.001)
 }
 
-func (u *onuUsecase) getStatus(OnuStatusOID, onuID string) (string, error) {
+func (u *onuUsecase) getStatus(ctx context.Context, OnuStatusOID, onuID string) (string, error) {
 	oid := u.cfg.OltCfg.BaseOID1 + OnuStatusOID + "." + onuID
-	result, err := u.getFromSNMPWithSingleflight(oid)
+	result, err := u.getFromSNMPWithSingleflight(ctx, oid)
 	if err != nil {
 		return "", err
 	}
 	return utils.ExtractAndGetStatus(result.Variables[0].Value), nil
 }
 
-func (u *onuUsecase) getIPAddress(OnuIPAddressOID, onuID string) (string, error) {
-	oid := u.cfg.OltCfg.BaseOID2 + OnuIPAddressOID + "." + onuID + ".1"
-	result, err := u.getFromSNMPWithSingleflight(oid)
+func (u *onuUsecase) getUptimeDuration(lastOnline string) (string, error) {
+	currentTime := time.Now()
+
+	lastOnlineTime, err := time.ParseInLocation("2006-01-02 15:04:05", lastOnline, u.GetOltLocation())
 	if err != nil {
+		log.Error().Msg("Failed to parse last online time: " + err.Error())
 		return "", err
 	}
-	return utils.ExtractName(result.Variables[0].Value), nil
+
+	duration := currentTime.Sub(lastOnlineTime)
+	if duration < 0 {
+		duration = 0 // OLT clock ahead of ours
+	}
+	return utils.ConvertDurationToString(duration), nil
 }
 
-func (u *onuUsecase) getDescription(OnuDescriptionOID, onuID string) (string, error) {
-	oid := u.cfg.OltCfg.BaseOID1 + OnuDescriptionOID + "." + onuID
-	result, err := u.getFromSNMPWithSingleflight(oid)
+// Last Down Duration
+func (u *onuUsecase) getLastDownDuration(lastOffline, lastOnline string) (string, error) {
+	lastOfflineTime, err := time.ParseInLocation("2006-01-02 15:04:05", lastOffline, u.GetOltLocation())
 	if err != nil {
+		log.Error().Msg("Failed to parse last offline time: " + err.Error())
 		return "", err
 	}
-	return utils.ExtractName(result.Variables[0].Value), nil
-}
 
-func (u *onuUsecase) getLastOnline(OnuLastOnlineOID, onuID string) (string, error) {
-	oid := u.cfg.OltCfg.BaseOID1 + OnuLastOnlineOID + "." + onuID
-	result, err := u.getFromSNMPWithSingleflight(oid)
+	lastOnlineTime, err := time.ParseInLocation("2006-01-02 15:04:05", lastOnline, u.GetOltLocation())
 	if err != nil {
+		log.Error().Msg("Failed to parse last online time: " + err.Error())
 		return "", err
 	}
 
-	value := result.Variables[0].Value.([]byte)
-	return utils.ConvertByteArrayToDateTime(value)
+	duration := lastOnlineTime.Sub(lastOfflineTime)
+	return utils.ConvertDurationToString(duration), nil
 }
 
-func (u *onuUsecase) getLastOffline(OnuLastOfflineOID, onuID string) (string, error) {
-	baseOID := u.cfg.OltCfg.BaseOID1
-	oid := baseOID + OnuLastOfflineOID + "." + onuID
-	oids := []string{oid}
-
-	result, err, _ := u.sg.Do(oid, func() (interface{}, error) {
-		return u.snmpRepository.Get(oids)
-	})
-	if err != nil {
-		log.Error().Msg("Failed to perform SNMP Get for last offline: " + err.Error())
-		return "", errors.New("failed to perform SNMP Get")
+// GetOltLocation returns the timezone of the OLT clock from OltCfg.Timezone. A configured zone is resolved once,
+// "auto" reads the UTC offset from the OLT hrSystemDate and retries every minute until the OLT answers, using
+// the default zone meanwhile
+func (u *onuUsecase) GetOltLocation() *time.Location {
+	if u.cfg.OltCfg.Timezone == "auto" {
+		return u.detectedOltLocation()
 	}
 
-	resultData := result.(*gosnmp.SnmpPacket)
-	if len(resultData.Variables) > 0 {
-		value := resultData.Variables[0].Value.([]byte)
-		return utils.ConvertByteArrayToDateTime(value)
-	}
+	u.locationOnce.Do(func() {
+		timezone := u.cfg.OltCfg.Timezone
+		if timezone == "" {
+			timezone = defaultOltTimezone
+		}
 
-	log.Error().Msg("Failed to get ONU Last Offline: No variables in the response")
-	return "", errors.New("no variables in the response")
+		loc, err := utils.LoadLocation(timezone)
+		if err != nil {
+			log.Error().Msg("Invalid OLT timezone " + timezone + ", using " + defaultOltTimezone + ": " + err.Error())
+			loc, _ = utils.LoadLocation(defaultOltTimezone)
+		}
+		u.oltLocation = loc
+	})
+
+	return u.oltLocation
 }
 
-func (u *onuUsecase) getLastOfflineReason(OnuLastOfflineReasonOID, onuID string) (string, error) {
-	oid := u.cfg.OltCfg.BaseOID1 + OnuLastOfflineReasonOID + "." + onuID
-	result, err := u.getFromSNMPWithSingleflight(oid)
-	if err != nil {
-		return "", err
+// detectedOltLocation returns the zone detected from the OLT, detecting it again when the last attempt failed
+// more than oltLocationRetryInterval ago. The detection runs outside the lock with a timeout, the other callers
+// get the default zone meanwhile instead of waiting for the OLT.
+func (u *onuUsecase) detectedOltLocation() *time.Location {
+	u.locationMu.Lock()
+	if u.oltLocation != nil {
+		defer u.locationMu.Unlock()
+		return u.oltLocation
 	}
+	detect := !u.locationBusy &&
+		(u.locationAttempt.IsZero() || time.Since(u.locationAttempt) >= oltLocationRetryInterval)
+	if detect {
+		u.locationBusy = true
+		u.locationAttempt = time.Now()
+	}
+	u.locationMu.Unlock()
 
-	return utils.ExtractLastOfflineReason(result.Variables[0].Value), nil
-}
+	if detect {
+		ctx, cancel := context.WithTimeout(context.Background(), u.locationTimeout)
+		loc, err := u.detectOltLocation(ctx)
+		cancel()
 
-func (u *onuUsecase) getOnuGponOpticalDistance(OnuGponOpticalDistanceOID, onuID string) (string, error) {
-	oid := u.cfg.OltCfg.BaseOID1 + OnuGponOpticalDistanceOID + "." + onuID
-	result, err := u.getFromSNMPWithSingleflight(oid)
-	if err != nil {
-		return "", err
+		u.locationMu.Lock()
+		u.locationBusy = false
+		if err == nil {
+			u.oltLocation = loc
+		}
+		u.locationMu.Unlock()
+
+		if err == nil {
+			log.Info().Msg("Detected OLT timezone from sysDate: " + loc.String())
+			return loc
+		}
+		log.Error().Msg("Failed to detect OLT timezone, using " + defaultOltTimezone + " until it is detected: " +
+			err.Error())
 	}
 
-	return utils.ExtractGponOpticalDistance(result.Variables[0].Value), nil
+	loc, _ := utils.LoadLocation(defaultOltTimezone)
+	return loc
 }
 
-func (u *onuUsecase) getUptimeDuration(lastOnline string) (string, error) {
-	currentTime := time.Now()
-
-	lastOnlineTime, err := time.Parse("2006-01-02 15:04:05", lastOnline)
-	if err != nil {
-		log.Error().Msg("Failed to parse last online time: " + err.Error())
-		return "", err
+// detectOltLocation reads the OLT hrSystemDate and returns a fixed zone with its UTC offset. The SNMP request is
+// not bound by the context, it is left to finish in the background when the context is done first.
+func (u *onuUsecase) detectOltLocation(ctx context.Context) (*time.Location, error) {
+	type getResult struct {
+		packet *gosnmp.SnmpPacket
+		err    error
+	}
+	done := make(chan getResult, 1)
+	go func() {
+		packet, err := u.snmpRepository.Get(ctx, []string{oltSystemDateOID})
+		done <- getResult{packet, err}
+	}()
+
+	var result *gosnmp.SnmpPacket
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case r := <-done:
+		if r.err != nil {
+			return nil, r.err
+		}
+		result = r.packet
+	}
+	if len(result.Variables) == 0 {
+		return nil, errors.New("no variables in the response")
 	}
 
-	duration := currentTime.Sub(lastOnlineTime) + time.Hour*7
-	return utils.ConvertDurationToString(duration), nil
-}
+	value, ok := result.Variables[0].Value.([]byte)
+	if !ok || len(value) != 11 {
+		return nil, errors.New("sysDate has no UTC offset")
+	}
 
-// Last Down Duration
-func (u *onuUsecase) getLastDownDuration(lastOffline, lastOnline string) (string, error) {
-	lastOfflineTime, err := time.Parse("2006-01-02 15:04:05", lastOffline)
+	sysDate, err := utils.ParseDateAndTime(value, time.UTC)
 	if err != nil {
-		log.Error().Msg("Failed to parse last offline time: " + err.Error())
-		return "", err
+		return nil, err
 	}
 
-	lastOnlineTime, err := time.Parse("2006-01-02 15:04:05", lastOnline)
+	_, offset := sysDate.Zone()
+	return time.FixedZone(sysDate.Format("UTC-07:00"), offset), nil
+}
+
+// walkOnuUniColumn walks one column of the ONU UNI table and returns the values keyed by UNI port number
+func (u *onuUsecase) walkOnuUniColumn(ctx context.Context, OnuUniColumnOID, onuID string) (map[int]interface{}, error) {
+	values := make(map[int]interface{})
+	err := u.snmpRepository.Walk(ctx, u.cfg.OltCfg.BaseOID2+OnuUniColumnOID+"."+onuID, func(pdu gosnmp.SnmpPDU) error {
+		values[utils.ExtractIDOnuID(pdu.Name)] = pdu.Value
+		return nil
+	})
 	if err != nil {
-		log.Error().Msg("Failed to parse last online time: " + err.Error())
-		return "", err
+		return nil, err
 	}
-
-	duration := lastOnlineTime.Sub(lastOfflineTime)
-	return utils.ConvertDurationToString(duration), nil
+	return values, nil
 }
 
-func (u *onuUsecase) getFromSNMPWithSingleflight(oid string) (*gosnmp.SnmpPacket, error) {
-	result, err, _ := u.sg.Do(oid, func() (interface{}, error) {
-		return u.snmpRepository.Get([]string{oid})
+func (u *onuUsecase) getFromSNMPWithSingleflight(ctx context.Context, oid string) (*gosnmp.SnmpPacket, error) {
+	result, err := u.doShared("snmp_get", oid, func() (interface{}, error) {
+		return u.snmpRepository.Get(ctx, []string{oid})
 	})
 	if err != nil {
 		log.Error().Msg("Failed to perform SNMP Get for OID " + oid + ": " + err.Error())
@@ -0,0 +1,425 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gosnmp/gosnmp"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/utils"
+	"github.com/rs/zerolog/log"
+)
+
+// ErrUnknownOnuField is returned when a requested ONU detail field does not exist
+var ErrUnknownOnuField = errors.New("unknown onu field")
+
+// onuDetailField describes how a field of model.ONUCustomerInfo is read from the OLT.
+// Fields with an OID are fetched in one SNMP Get per base OID, derived fields are computed from
+// the fields they depend on.
+type onuDetailField struct {
+	name      string                                  // JSON name of the field in model.ONUCustomerInfo
+	baseOID2  bool                                    // true for BaseOID2 (.1012), false for BaseOID1 (.1082)
+	column    func(oltConfig *model.OltConfig) string // OID column of the field, nil for derived fields
+	suffix    string                                  // Index suffix after the ONU ID
+	decode    func(u *onuUsecase, info *model.ONUCustomerInfo, value interface{})
+	dependsOn []string // Fields needed to compute a derived field
+}
+
+// onuDetailFields lists every ONU detail field in the order of model.ONUCustomerInfo
+var onuDetailFields = []onuDetailField{
+	{
+		name:   "description",
+		column: func(c *model.OltConfig) string { return c.OnuDescriptionOID },
+		decode: func(_ *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			info.Description = utils.ExtractName(value)
+		},
+	},
+	{
+		name:     "onu_type",
+		baseOID2: true,
+		column:   func(c *model.OltConfig) string { return c.OnuTypeOID },
+		decode: func(_ *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			info.OnuType = utils.ExtractName(value)
+		},
+	},
+	{
+		name:   "serial_number",
+		column: func(c *model.OltConfig) string { return c.OnuSerialNumberOID },
+		decode: func(_ *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			info.SerialNumber = utils.ExtractSerialNumber(value)
+		},
+	},
+	{
+		name:   "rx_power",
+		column: func(c *model.OltConfig) string { return c.OnuRxPowerOID },
+		suffix: ".1",
+		decode: func(_ *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			info.RXPower, _ = utils.ConvertAndMultiply(value)
+		},
+	},
+	{
+		name:     "tx_power",
+		baseOID2: true,
+		column:   func(c *model.OltConfig) string { return c.OnuTxPowerOID },
+		suffix:   ".1",
+		decode: func(_ *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			info.TXPower, _ = utils.ConvertAndMultiply(value)
+		},
+	},
+	{
+		name:   "olt_rx_power",
+		column: func(c *model.OltConfig) string { return c.OnuOltRxPowerOID },
+		decode: func(_ *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			info.OltRXPower, _ = utils.ConvertAndScale(value, 0.001)
+		},
+	},
+	{
+		name:     "temperature",
+		baseOID2: true,
+		column:   func(c *model.OltConfig) string { return c.OnuTemperatureOID },
+		suffix:   ".1",
+		decode: func(_ *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			info.Temperature, _ = utils.ConvertAndScale(value, 0.001)
+		},
+	},
+	{
+		name:     "voltage",
+		baseOID2: true,
+		column:   func(c *model.OltConfig) string { return c.OnuVoltageOID },
+		suffix:   ".1",
+		decode: func(_ *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			info.Voltage, _ = utils.ConvertAndScale(value, 0.001)
+		},
+	},
+	{
+		name:     "bias_current",
+		baseOID2: true,
+		column:   func(c *model.OltConfig) string { return c.OnuBiasCurrentOID },
+		suffix:   ".1",
+		decode: func(_ *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			info.BiasCurrent, _ = utils.ConvertAndScale(value, 0.001)
+		},
+	},
+	{
+		name:   "status",
+		column: func(c *model.OltConfig) string { return c.OnuStatusOID },
+		decode: func(_ *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			info.Status = utils.ExtractAndGetStatus(value)
+		},
+	},
+	{
+		name:     "ip_address",
+		baseOID2: true,
+		column:   func(c *model.OltConfig) string { return c.OnuIPAddressOID },
+		suffix:   ".1",
+		decode: func(_ *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			info.IPAddress = utils.ExtractName(value)
+		},
+	},
+	{
+		name:   "last_online",
+		column: func(c *model.OltConfig) string { return c.OnuLastOnlineOID },
+		decode: func(u *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			if byteArray, ok := value.([]byte); ok {
+				info.LastOnline, _ = utils.ConvertByteArrayToDateTime(byteArray, u.GetOltLocation())
+			}
+		},
+	},
+	{
+		name:   "last_offline",
+		column: func(c *model.OltConfig) string { return c.OnuLastOfflineOID },
+		decode: func(u *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			if byteArray, ok := value.([]byte); ok {
+				info.LastOffline, _ = utils.ConvertByteArrayToDateTime(byteArray, u.GetOltLocation())
+			}
+		},
+	},
+	{
+		name:      "uptime",
+		dependsOn: []string{"last_online"},
+		decode: func(u *onuUsecase, info *model.ONUCustomerInfo, _ interface{}) {
+			if uptime, err := u.getUptimeDuration(info.LastOnline); err == nil {
+				info.Uptime = uptime
+			}
+		},
+	},
+	{
+		name:      "last_down_time_duration",
+		dependsOn: []string{"last_online", "last_offline"},
+		decode: func(u *onuUsecase, info *model.ONUCustomerInfo, _ interface{}) {
+			if downtime, err := u.getLastDownDuration(info.LastOffline, info.LastOnline); err == nil {
+				info.LastDownTimeDuration = downtime
+			}
+		},
+	},
+	{
+		name:   "offline_reason",
+		column: func(c *model.OltConfig) string { return c.OnuLastOfflineReasonOID },
+		decode: func(_ *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			info.LastOfflineReason = utils.ExtractLastOfflineReason(value)
+		},
+	},
+	{
+		name:   "gpon_optical_distance",
+		column: func(c *model.OltConfig) string { return c.OnuGponOpticalDistanceOID },
+		decode: func(_ *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			info.GponOpticalDistance = utils.ExtractGponOpticalDistance(value)
+		},
+	},
+	{
+		name:     "hardware_version",
+		baseOID2: true,
+		column:   func(c *model.OltConfig) string { return c.OnuHardwareVersionOID },
+		decode: func(_ *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			info.HardwareVersion = utils.ExtractName(value)
+		},
+	},
+	{
+		name:     "software_version_active",
+		baseOID2: true,
+		column:   func(c *model.OltConfig) string { return c.OnuSoftwareVersionActiveOID },
+		decode: func(_ *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			info.SoftwareVersionActive = utils.ExtractName(value)
+		},
+	},
+	{
+		name:     "software_version_standby",
+		baseOID2: true,
+		column:   func(c *model.OltConfig) string { return c.OnuSoftwareVersionStandbyOID },
+		decode: func(_ *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			info.SoftwareVersionStandby = utils.ExtractName(value)
+		},
+	},
+	{
+		name:     "equipment_id",
+		baseOID2: true,
+		column:   func(c *model.OltConfig) string { return c.OnuEquipmentIDOID },
+		decode: func(_ *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			info.EquipmentID = utils.ExtractName(value)
+		},
+	},
+	{
+		name:     "traffic_upstream_bytes",
+		baseOID2: true,
+		column:   func(c *model.OltConfig) string { return c.OnuUpstreamBytesOID },
+		decode: func(_ *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			if counter, err := utils.ConvertCounterToUint64(value); err == nil {
+				info.Traffic.UpstreamBytes, info.TrafficRead = counter, true
+			}
+		},
+	},
+	{
+		name:     "traffic_downstream_bytes",
+		baseOID2: true,
+		column:   func(c *model.OltConfig) string { return c.OnuDownstreamBytesOID },
+		decode: func(_ *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			if counter, err := utils.ConvertCounterToUint64(value); err == nil {
+				info.Traffic.DownstreamBytes, info.TrafficRead = counter, true
+			}
+		},
+	},
+	{
+		name:     "traffic_upstream_packets",
+		baseOID2: true,
+		column:   func(c *model.OltConfig) string { return c.OnuUpstreamPacketsOID },
+		decode: func(_ *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			if counter, err := utils.ConvertCounterToUint64(value); err == nil {
+				info.Traffic.UpstreamPackets, info.TrafficRead = counter, true
+			}
+		},
+	},
+	{
+		name:     "traffic_downstream_packets",
+		baseOID2: true,
+		column:   func(c *model.OltConfig) string { return c.OnuDownstreamPacketsOID },
+		decode: func(_ *onuUsecase, info *model.ONUCustomerInfo, value interface{}) {
+			if counter, err := utils.ConvertCounterToUint64(value); err == nil {
+				info.Traffic.DownstreamPackets, info.TrafficRead = counter, true
+			}
+		},
+	},
+	{
+		name:      "traffic",
+		dependsOn: []string{"traffic_upstream_bytes", "traffic_downstream_bytes", "traffic_upstream_packets", "traffic_downstream_packets"},
+		// The rates depend on the consumer of the call, they are applied by GetByBoardIDPonIDAndOnuIDWithFields
+		decode: func(*onuUsecase, *model.ONUCustomerInfo, interface{}) {},
+	},
+}
+
+// OnuDetailFields returns the names of the fields that can be selected for the ONU detail
+func OnuDetailFields() []string {
+	var names []string
+	for _, field := range onuDetailFields {
+		if !strings.HasPrefix(field.name, "traffic_") {
+			names = append(names, field.name)
+		}
+	}
+	return names
+}
+
+// resolveOnuDetailFields returns the set of fields to fetch for the selected fields, including the fields
+// derived fields depend on. No selection means every field.
+func resolveOnuDetailFields(selected []string) (map[string]bool, error) {
+	resolved := make(map[string]bool)
+	if len(selected) == 0 {
+		for _, field := range onuDetailFields {
+			resolved[field.name] = true
+		}
+		return resolved, nil
+	}
+
+	known := make(map[string]onuDetailField)
+	for _, field := range onuDetailFields {
+		if !strings.HasPrefix(field.name, "traffic_") {
+			known[field.name] = field
+		}
+	}
+
+	for _, name := range selected {
+		if name == "name" {
+			continue // The name is always fetched, it tells whether the ONU exists
+		}
+		field, ok := known[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownOnuField, name)
+		}
+		resolved[name] = true
+		for _, dependency := range field.dependsOn {
+			resolved[dependency] = true
+		}
+	}
+
+	return resolved, nil
+}
+
+// GetByBoardIDPonIDAndOnuIDWithFields returns the detail of an ONU with only the selected fields filled.
+// The name and every selected field with an OID are read in one SNMP Get per base OID, so a full
+// detail costs two SNMP requests. An ONU that does not exist returns an empty model.ONUCustomerInfo.
+func (u *onuUsecase) GetByBoardIDPonIDAndOnuIDWithFields(ctx context.Context, boardID, ponID, onuID int, fields []string) (
+	model.ONUCustomerInfo, error,
+) {
+	resolved, err := resolveOnuDetailFields(fields)
+	if err != nil {
+		return model.ONUCustomerInfo{}, err
+	}
+
+	// Set key for simple flight, requests for different fields or traffic consumers are not shared
+	consumer := trafficConsumer(ctx)
+	key := fmt.Sprintf("onu:%d:%d:%d:%s:%s", boardID, ponID, onuID, strings.Join(fields, ","), consumer)
+
+	// Using simple flight to prevent duplicate SNMP requests
+	result, err := u.doShared("onu_detail", key, func() (interface{}, error) {
+		oltConfig, err := u.getOltConfig(boardID, ponID) // Get OLT config based on Board ID and PON ID
+		if err != nil {
+			log.Error().Msg("Failed to get OLT Config: " + err.Error())
+			return model.ONUCustomerInfo{}, err
+		}
+
+		log.Info().Msg("Get Detail ONU Information with SNMP Get from Board ID: " +
+			strconv.Itoa(boardID) + " PON ID: " + strconv.Itoa(ponID) +
+			" ONU ID: " + strconv.Itoa(onuID))
+
+		index := "." + strconv.Itoa(onuID)
+		nameOID := u.cfg.OltCfg.BaseOID1 + oltConfig.OnuIDNameOID + index
+
+		// Group the OIDs of the fields to fetch by base OID, the name is always read with BaseOID1
+		oidsBaseOID1 := []string{nameOID}
+		var oidsBaseOID2 []string
+		fieldByOID := make(map[string]onuDetailField)
+		for _, field := range onuDetailFields {
+			if !resolved[field.name] || field.column == nil {
+				continue
+			}
+
+			if field.baseOID2 {
+				oid := u.cfg.OltCfg.BaseOID2 + field.column(oltConfig) + index + field.suffix
+				oidsBaseOID2 = append(oidsBaseOID2, oid)
+				fieldByOID[oid] = field
+			} else {
+				oid := u.cfg.OltCfg.BaseOID1 + field.column(oltConfig) + index + field.suffix
+				oidsBaseOID1 = append(oidsBaseOID1, oid)
+				fieldByOID[oid] = field
+			}
+		}
+
+		variables, err := u.getVariables(ctx, oidsBaseOID1)
+		if err != nil {
+			return model.ONUCustomerInfo{}, err
+		}
+
+		// The ONU does not exist when the OLT has no name for it
+		name, ok := variables[nameOID]
+		if !ok {
+			return model.ONUCustomerInfo{}, nil
+		}
+
+		onuInfo := model.ONUCustomerInfo{
+			Board: boardID,
+			PON:   ponID,
+			ID:    onuID,
+			Name:  utils.ExtractName(name.Value),
+		}
+
+		// A failed BaseOID2 Get only leaves its fields empty, like a failed Get of a single field
+		if len(oidsBaseOID2) > 0 {
+			variablesBaseOID2, err := u.getVariables(ctx, oidsBaseOID2)
+			if err != nil {
+				log.Error().Msg("Failed to get ONU detail from base OID 2: " + err.Error())
+			}
+			for oid, variable := range variablesBaseOID2 {
+				variables[oid] = variable
+			}
+		}
+
+		// Decode the fetched fields first, in any order since each sets its own field, then the derived fields
+		// in the order of onuDetailFields
+		for oid, variable := range variables {
+			if field, ok := fieldByOID[oid]; ok {
+				field.decode(u, &onuInfo, variable.Value)
+			}
+		}
+		for _, field := range onuDetailFields {
+			if resolved[field.name] && field.column == nil {
+				field.decode(u, &onuInfo, nil)
+			}
+		}
+
+		// Without counters in the response the previous reading is kept for the next rate
+		if resolved["traffic"] && onuInfo.TrafficRead {
+			onuInfo.Traffic = u.applyTrafficRate(consumer, boardID, ponID, onuID, onuInfo.Traffic, time.Now())
+		}
+
+		return onuInfo, nil
+	})
+
+	if err != nil {
+		return model.ONUCustomerInfo{}, err
+	}
+
+	return result.(model.ONUCustomerInfo), nil // Return the result from the cache or SNMP Get
+}
+
+// getVariables performs one multi-varbind SNMP Get and returns the variables keyed by OID, skipping
+// OIDs the OLT does not have
+func (u *onuUsecase) getVariables(ctx context.Context, oids []string) (map[string]gosnmp.SnmpPDU, error) {
+	result, err := u.snmpRepository.Get(ctx, oids)
+	if err != nil {
+		log.Error().Msg("Failed to perform SNMP Get: " + err.Error())
+		return nil, errors.New("failed to perform SNMP Get")
+	}
+
+	variables := make(map[string]gosnmp.SnmpPDU)
+	for _, variable := range result.Variables {
+		switch variable.Type {
+		case gosnmp.NoSuchObject, gosnmp.NoSuchInstance, gosnmp.EndOfMibView, gosnmp.Null:
+			continue
+		}
+		variables[variable.Name] = variable
+	}
+
+	return variables, nil
+}
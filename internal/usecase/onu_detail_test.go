@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testDetailOIDs are the OIDs of the detail of ONU 3 on board 1 PON 1 in the test config
+type testDetailOIDs struct {
+	name, status, rxPower, lastOnline, txPower string
+}
+
+// newTestDetail creates the usecase with ONU 3 on board 1 PON 1 on the fake OLT
+func newTestDetail() (*onuUsecase, *fakeSnmpRepository, testDetailOIDs) {
+	u, snmpRepository, _ := newTestUsecase()
+	pon := u.cfg.Board1Pon1
+	oids := testDetailOIDs{
+		name:       testBaseOID1 + pon.OnuIDNameOID + ".3",
+		status:     testBaseOID1 + pon.OnuStatusOID + ".3",
+		rxPower:    testBaseOID1 + pon.OnuRxPowerOID + ".3.1",
+		lastOnline: testBaseOID1 + pon.OnuLastOnlineOID + ".3",
+		txPower:    testBaseOID2 + pon.OnuTxPowerOID + ".3.1",
+	}
+
+	snmpRepository.values[oids.name] = "Isroh"
+	snmpRepository.values[oids.status] = 4
+	snmpRepository.values[oids.rxPower] = 4640
+	snmpRepository.values[oids.txPower] = 16285
+	// 2024-08-11 10:09:37 without a UTC offset
+	snmpRepository.values[oids.lastOnline] = []byte{0x07, 0xe8, 8, 11, 10, 9, 37, 0}
+	return u, snmpRepository, oids
+}
+
+func TestGetByBoardIDPonIDAndOnuIDWithFieldsFullDetail(t *testing.T) {
+	u, snmpRepository, _ := newTestDetail()
+
+	onuInfo, err := u.GetByBoardIDPonIDAndOnuID(context.Background(), 1, 1, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 3, onuInfo.ID)
+	assert.Equal(t, "Isroh", onuInfo.Name)
+	assert.Equal(t, "Online", onuInfo.Status)
+	assert.Equal(t, "-20.72", onuInfo.RXPower)
+	assert.Equal(t, "2.57", onuInfo.TXPower)
+	assert.Equal(t, "2024-08-11 10:09:37", onuInfo.LastOnline)
+
+	// One Get per base OID
+	require.Len(t, snmpRepository.getOIDs, 2)
+	for _, oid := range snmpRepository.getOIDs[0] {
+		assert.True(t, strings.HasPrefix(oid, testBaseOID1+"."), oid)
+	}
+	for _, oid := range snmpRepository.getOIDs[1] {
+		assert.True(t, strings.HasPrefix(oid, testBaseOID2+"."), oid)
+	}
+}
+
+func TestGetByBoardIDPonIDAndOnuIDWithFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		fields   []string
+		wantOIDs func(oids testDetailOIDs) [][]string
+		check    func(t *testing.T, onuInfo model.ONUCustomerInfo)
+	}{
+		{
+			name:   "selected fields of one base OID",
+			fields: []string{"status", "rx_power"},
+			wantOIDs: func(oids testDetailOIDs) [][]string {
+				return [][]string{{oids.name, oids.rxPower, oids.status}}
+			},
+			check: func(t *testing.T, onuInfo model.ONUCustomerInfo) {
+				assert.Equal(t, "Online", onuInfo.Status)
+				assert.Equal(t, "-20.72", onuInfo.RXPower)
+				assert.Empty(t, onuInfo.TXPower)
+			},
+		},
+		{
+			name:   "derived field fetches its dependency",
+			fields: []string{"uptime"},
+			wantOIDs: func(oids testDetailOIDs) [][]string {
+				return [][]string{{oids.name, oids.lastOnline}}
+			},
+			check: func(t *testing.T, onuInfo model.ONUCustomerInfo) {
+				assert.NotEmpty(t, onuInfo.Uptime)
+				assert.Empty(t, onuInfo.Status)
+			},
+		},
+		{
+			name:   "fields of both base OIDs",
+			fields: []string{"status", "tx_power"},
+			wantOIDs: func(oids testDetailOIDs) [][]string {
+				return [][]string{{oids.name, oids.status}, {oids.txPower}}
+			},
+			check: func(t *testing.T, onuInfo model.ONUCustomerInfo) {
+				assert.Equal(t, "Online", onuInfo.Status)
+				assert.Equal(t, "2.57", onuInfo.TXPower)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, snmpRepository, oids := newTestDetail()
+
+			onuInfo, err := u.GetByBoardIDPonIDAndOnuIDWithFields(context.Background(), 1, 1, 3, tt.fields)
+			require.NoError(t, err)
+			assert.Equal(t, "Isroh", onuInfo.Name)
+			assert.Equal(t, tt.wantOIDs(oids), snmpRepository.getOIDs)
+			tt.check(t, onuInfo)
+		})
+	}
+}
+
+func TestGetByBoardIDPonIDAndOnuIDWithFieldsSkipsMissingValues(t *testing.T) {
+	u, snmpRepository, oids := newTestDetail()
+	// The OLT answers NoSuchInstance for the status
+	delete(snmpRepository.values, oids.status)
+
+	onuInfo, err := u.GetByBoardIDPonIDAndOnuIDWithFields(context.Background(), 1, 1, 3, []string{"status", "rx_power"})
+	require.NoError(t, err)
+	assert.Empty(t, onuInfo.Status, "a missing value is not decoded as Unknown")
+	assert.Equal(t, "-20.72", onuInfo.RXPower)
+}
+
+func TestGetByBoardIDPonIDAndOnuIDWithFieldsOnuNotFound(t *testing.T) {
+	u, snmpRepository, oids := newTestDetail()
+	delete(snmpRepository.values, oids.name)
+
+	onuInfo, err := u.GetByBoardIDPonIDAndOnuID(context.Background(), 1, 1, 3)
+	require.NoError(t, err)
+	assert.Equal(t, model.ONUCustomerInfo{}, onuInfo)
+	assert.Equal(t, 1, snmpRepository.gets, "the BaseOID2 fields are not read for a missing ONU")
+}
+
+func TestGetByBoardIDPonIDAndOnuIDWithFieldsBaseOID2Fails(t *testing.T) {
+	u, snmpRepository, oids := newTestDetail()
+	snmpRepository.failing[oids.txPower] = true
+
+	onuInfo, err := u.GetByBoardIDPonIDAndOnuID(context.Background(), 1, 1, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "Isroh", onuInfo.Name)
+	assert.Equal(t, "Online", onuInfo.Status)
+	assert.Equal(t, "-20.72", onuInfo.RXPower)
+	assert.Empty(t, onuInfo.TXPower)
+	assert.False(t, onuInfo.TrafficRead)
+}
+
+func TestGetByBoardIDPonIDAndOnuIDWithFieldsBaseOID1Fails(t *testing.T) {
+	u, snmpRepository, oids := newTestDetail()
+	snmpRepository.failing[oids.status] = true
+
+	_, err := u.GetByBoardIDPonIDAndOnuID(context.Background(), 1, 1, 3)
+	assert.Error(t, err)
+}
+
+func TestGetByBoardIDPonIDAndOnuIDWithFieldsUnknownField(t *testing.T) {
+	u, snmpRepository, _ := newTestDetail()
+
+	_, err := u.GetByBoardIDPonIDAndOnuIDWithFields(context.Background(), 1, 1, 3, []string{"status", "vlan"})
+	assert.ErrorIs(t, err, ErrUnknownOnuField)
+	assert.Zero(t, snmpRepository.gets)
+
+	// The traffic counters are only selected through traffic
+	_, err = u.GetByBoardIDPonIDAndOnuIDWithFields(context.Background(), 1, 1, 3, []string{"traffic_upstream_bytes"})
+	assert.ErrorIs(t, err, ErrUnknownOnuField)
+}
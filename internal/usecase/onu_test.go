@@ -0,0 +1,544 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gosnmp/gosnmp"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/config"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/repository"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSnmpRepository serves the walks and gets of an OLT from tables keyed by OID, unknown OIDs are empty
+type fakeSnmpRepository struct {
+	mu      sync.Mutex
+	columns map[string]map[int]interface{} // walked OID to the value of every ONU ID
+	values  map[string]interface{}         // OID of a Get to its value
+	failing map[string]bool                // OIDs that time out
+	walks   map[string]int                 // number of walks per OID
+	gets    int                            // number of Gets
+	getOIDs [][]string                     // OIDs of every Get
+	suffix  map[string]string              // index after the ONU ID of a walked OID, e.g. ".1"
+	onWalk  func(oid string)               // called after every walk, e.g. to advance counters
+	onGet   func()                         // called before every Get, e.g. to block like an unreachable OLT
+}
+
+func newFakeSnmpRepository() *fakeSnmpRepository {
+	return &fakeSnmpRepository{
+		columns: make(map[string]map[int]interface{}),
+		values:  make(map[string]interface{}),
+		failing: make(map[string]bool),
+		walks:   make(map[string]int),
+		suffix:  make(map[string]string),
+	}
+}
+
+func (f *fakeSnmpRepository) Get(_ context.Context, oids []string) (*gosnmp.SnmpPacket, error) {
+	if f.onGet != nil {
+		f.onGet()
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.gets++
+	f.getOIDs = append(f.getOIDs, oids)
+	packet := &gosnmp.SnmpPacket{}
+	for _, oid := range oids {
+		if f.failing[oid] {
+			return nil, errors.New("request timeout")
+		}
+		value, ok := f.values[oid]
+		if !ok {
+			packet.Variables = append(packet.Variables, gosnmp.SnmpPDU{Name: oid, Type: gosnmp.NoSuchInstance})
+			continue
+		}
+		packet.Variables = append(packet.Variables, gosnmp.SnmpPDU{Name: oid, Type: pduType(value), Value: value})
+	}
+	return packet, nil
+}
+
+func (f *fakeSnmpRepository) Walk(_ context.Context, oid string, walkFunc func(pdu gosnmp.SnmpPDU) error) error {
+	f.mu.Lock()
+	f.walks[oid]++
+	failing, column, suffix := f.failing[oid], f.columns[oid], f.suffix[oid]
+	f.mu.Unlock()
+
+	if failing {
+		return errors.New("request timeout")
+	}
+	for onuID, value := range column {
+		pdu := gosnmp.SnmpPDU{Name: oid + "." + strconv.Itoa(onuID) + suffix, Type: pduType(value), Value: value}
+		if err := walkFunc(pdu); err != nil {
+			return err
+		}
+	}
+	if f.onWalk != nil {
+		f.onWalk(oid)
+	}
+	return nil
+}
+
+// pduType is the SNMP type of a value of the fake tables
+func pduType(value interface{}) gosnmp.Asn1BER {
+	switch value.(type) {
+	case string, []byte:
+		return gosnmp.OctetString
+	case uint64:
+		return gosnmp.Counter64
+	default:
+		return gosnmp.Integer
+	}
+}
+
+// fakeOnuRedisRepository is an in-memory ONU cache, the methods that are not needed panic on the nil interface
+type fakeOnuRedisRepository struct {
+	repository.OnuRedisRepositoryInterface
+	mu           sync.Mutex
+	versionLists map[string][]model.OnuVersion
+	infoLists    map[string][]model.ONUInfoPerBoard
+	ttls         map[string]int
+}
+
+func newFakeOnuRedisRepository() *fakeOnuRedisRepository {
+	return &fakeOnuRedisRepository{
+		versionLists: make(map[string][]model.OnuVersion),
+		infoLists:    make(map[string][]model.ONUInfoPerBoard),
+		ttls:         make(map[string]int),
+	}
+}
+
+func (f *fakeOnuRedisRepository) GetOnuVersionList(_ context.Context, key string) ([]model.OnuVersion, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	onuVersionList, ok := f.versionLists[key]
+	if !ok {
+		return nil, redis.Nil
+	}
+	return onuVersionList, nil
+}
+
+func (f *fakeOnuRedisRepository) SaveOnuVersionList(
+	_ context.Context, key string, seconds int, onuVersionList []model.OnuVersion,
+) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.versionLists[key] = onuVersionList
+	f.ttls[key] = seconds
+	return nil
+}
+
+func (f *fakeOnuRedisRepository) GetONUInfoList(_ context.Context, key string) ([]model.ONUInfoPerBoard, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	onuInfoList, ok := f.infoLists[key]
+	if !ok {
+		return nil, redis.Nil
+	}
+	return onuInfoList, nil
+}
+
+func (f *fakeOnuRedisRepository) SaveONUInfoList(
+	_ context.Context, key string, seconds int, onuInfoList []model.ONUInfoPerBoard,
+) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.infoLists[key] = onuInfoList
+	f.ttls[key] = seconds
+	return nil
+}
+
+// Column OIDs of the test config, relative to the base OIDs
+const (
+	testBaseOID1 = ".1.3.6.1.4.1.3902.1082"
+	testBaseOID2 = ".1.3.6.1.4.1.3902.1012"
+)
+
+// testPonOIDs returns the column OIDs of a PON in the test config, every PON has its own table index
+func testPonOIDs(index int) config.Board1Pon1 {
+	suffix := "." + strconv.Itoa(index)
+	return config.Board1Pon1{
+		OnuIDNameOID:                ".500.10.2.3.3.1.2" + suffix,
+		OnuTypeOID:                  ".3.50.11.2.1.17" + suffix,
+		OnuSerialNumberOID:          ".500.10.2.3.3.1.18" + suffix,
+		OnuRxPowerOID:               ".500.20.2.2.2.1.10" + suffix,
+		OnuTxPowerOID:               ".3.50.12.1.1.14" + suffix,
+		OnuStatusOID:                ".500.10.2.3.8.1.4" + suffix,
+		OnuSoftwareVersionActiveOID: ".3.50.11.2.1.2" + suffix,
+		OnuUpstreamBytesOID:         ".500.10.2.3.9.1.1" + suffix,
+		OnuDownstreamBytesOID:       ".500.10.2.3.9.1.2" + suffix,
+		OnuUpstreamPacketsOID:       ".500.10.2.3.9.1.3" + suffix,
+		OnuDownstreamPacketsOID:     ".500.10.2.3.9.1.4" + suffix,
+		OnuOltRxPowerOID:            ".500.1.2.4.2.1.2" + suffix,
+		OnuLastOnlineOID:            ".500.10.2.3.8.1.5" + suffix,
+		OnuLastOfflineOID:           ".500.10.2.3.8.1.6" + suffix,
+		OnuUniAdminStateOID:         ".3.50.14.1.1.5" + suffix,
+		OnuUniLinkStateOID:          ".3.50.14.1.1.7" + suffix,
+		OnuUniSpeedOID:              ".3.50.14.1.1.8" + suffix,
+		OnuUniDuplexOID:             ".3.50.14.1.1.9" + suffix,
+	}
+}
+
+// newTestConfig configures board 1 PON 1, board 1 PON 2 and board 2 PON 16, the other PONs have empty OIDs
+func newTestConfig() *config.Config {
+	cfg := &config.Config{OltCfg: config.OltConfig{BaseOID1: testBaseOID1, BaseOID2: testBaseOID2}}
+	cfg.Board1Pon1 = testPonOIDs(1)
+	cfg.Board1Pon2 = config.Board1Pon2(testPonOIDs(2))
+	cfg.Board2Pon16 = config.Board2Pon16(testPonOIDs(32))
+	return cfg
+}
+
+// newTestUsecase creates the usecase with the fakes and the test config
+func newTestUsecase() (*onuUsecase, *fakeSnmpRepository, *fakeOnuRedisRepository) {
+	snmpRepository := newFakeSnmpRepository()
+	redisRepository := newFakeOnuRedisRepository()
+	u := NewOnuUsecase(snmpRepository, redisRepository, newTestConfig()).(*onuUsecase)
+	return u, snmpRepository, redisRepository
+}
+
+// addOnuVersions adds the type and active firmware version columns of a PON to the fake OLT
+func addOnuVersions(snmpRepository *fakeSnmpRepository, pon config.Board1Pon1, versions map[int][2]string) {
+	types, active := make(map[int]interface{}), make(map[int]interface{})
+	for onuID, version := range versions {
+		types[onuID] = version[0]
+		active[onuID] = version[1]
+	}
+	snmpRepository.columns[testBaseOID2+pon.OnuTypeOID] = types
+	snmpRepository.columns[testBaseOID2+pon.OnuSoftwareVersionActiveOID] = active
+}
+
+func TestGetOnuFirmwareInventory(t *testing.T) {
+	tests := []struct {
+		name     string
+		onuType  string
+		version  string
+		expected []model.OnuFirmwareInventory
+	}{
+		{
+			name: "grouped by type and version",
+			expected: []model.OnuFirmwareInventory{
+				{OnuType: "F609", SoftwareVersion: "V6.0.10", Count: 1, Onus: []model.OnuID{{Board: 1, PON: 1, ID: 3}}},
+				{OnuType: "F660", SoftwareVersion: "V6.0.10", Count: 1, Onus: []model.OnuID{{Board: 2, PON: 16, ID: 5}}},
+				{OnuType: "F660", SoftwareVersion: "V8.0.10", Count: 2, Onus: []model.OnuID{
+					{Board: 1, PON: 1, ID: 1}, {Board: 1, PON: 1, ID: 2},
+				}},
+			},
+		},
+		{
+			name:    "onu type filter",
+			onuType: "F609",
+			expected: []model.OnuFirmwareInventory{
+				{OnuType: "F609", SoftwareVersion: "V6.0.10", Count: 1, Onus: []model.OnuID{{Board: 1, PON: 1, ID: 3}}},
+			},
+		},
+		{
+			name:    "version filter",
+			version: "V6.0.10",
+			expected: []model.OnuFirmwareInventory{
+				{OnuType: "F609", SoftwareVersion: "V6.0.10", Count: 1, Onus: []model.OnuID{{Board: 1, PON: 1, ID: 3}}},
+				{OnuType: "F660", SoftwareVersion: "V6.0.10", Count: 1, Onus: []model.OnuID{{Board: 2, PON: 16, ID: 5}}},
+			},
+		},
+		{
+			name:    "onu type and version filter",
+			onuType: "F660",
+			version: "V6.0.10",
+			expected: []model.OnuFirmwareInventory{
+				{OnuType: "F660", SoftwareVersion: "V6.0.10", Count: 1, Onus: []model.OnuID{{Board: 2, PON: 16, ID: 5}}},
+			},
+		},
+		{
+			name:     "no match",
+			onuType:  "F670L",
+			expected: []model.OnuFirmwareInventory{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, snmpRepository, _ := newTestUsecase()
+			addOnuVersions(snmpRepository, u.cfg.Board1Pon1, map[int][2]string{
+				1: {"F660", "V8.0.10"}, 2: {"F660", "V8.0.10"}, 3: {"F609", "V6.0.10"},
+			})
+			addOnuVersions(snmpRepository, config.Board1Pon1(u.cfg.Board2Pon16), map[int][2]string{
+				5: {"F660", "V6.0.10"},
+			})
+
+			inventoryList, err := u.GetOnuFirmwareInventory(context.Background(), tt.onuType, tt.version)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, inventoryList)
+		})
+	}
+}
+
+func TestGetOnuVersionListCache(t *testing.T) {
+	tests := []struct {
+		name          string
+		cached        []model.OnuVersion
+		expected      []model.OnuVersion
+		expectedWalks int
+	}{
+		{
+			name:          "redis miss walks the OLT and caches the list",
+			expected:      []model.OnuVersion{{Board: 1, PON: 1, ID: 1, OnuType: "F660", SoftwareVersion: "V8.0.10"}},
+			expectedWalks: 1,
+		},
+		{
+			name:     "redis hit does not walk the OLT",
+			cached:   []model.OnuVersion{{Board: 1, PON: 1, ID: 7, OnuType: "F609", SoftwareVersion: "V6.0.10"}},
+			expected: []model.OnuVersion{{Board: 1, PON: 1, ID: 7, OnuType: "F609", SoftwareVersion: "V6.0.10"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, snmpRepository, redisRepository := newTestUsecase()
+			addOnuVersions(snmpRepository, u.cfg.Board1Pon1, map[int][2]string{1: {"F660", "V8.0.10"}})
+			if tt.cached != nil {
+				redisRepository.versionLists["board_1_pon_1_onu_version"] = tt.cached
+			}
+
+			onuVersionList, err := u.getOnuVersionList(context.Background(), 1, 1)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, onuVersionList)
+			assert.Equal(t, tt.expectedWalks, snmpRepository.walks[testBaseOID2+u.cfg.Board1Pon1.OnuTypeOID])
+			assert.Equal(t, tt.expected, redisRepository.versionLists["board_1_pon_1_onu_version"])
+			if tt.cached == nil {
+				assert.Equal(t, 300, redisRepository.ttls["board_1_pon_1_onu_version"])
+			}
+		})
+	}
+}
+
+func TestGetOnuFirmwareInventoryPartialFailure(t *testing.T) {
+	u, snmpRepository, redisRepository := newTestUsecase()
+	addOnuVersions(snmpRepository, u.cfg.Board1Pon1, map[int][2]string{1: {"F660", "V8.0.10"}})
+	addOnuVersions(snmpRepository, config.Board1Pon1(u.cfg.Board1Pon2), map[int][2]string{1: {"F609", "V6.0.10"}})
+	snmpRepository.failing[testBaseOID2+u.cfg.Board1Pon2.OnuSoftwareVersionActiveOID] = true
+
+	// The PON that fails is left out and not cached, the inventory of the other PONs is still returned
+	inventoryList, err := u.GetOnuFirmwareInventory(context.Background(), "", "")
+	require.NoError(t, err)
+	assert.Equal(t, []model.OnuFirmwareInventory{
+		{OnuType: "F660", SoftwareVersion: "V8.0.10", Count: 1, Onus: []model.OnuID{{Board: 1, PON: 1, ID: 1}}},
+	}, inventoryList)
+	assert.NotContains(t, redisRepository.versionLists, "board_1_pon_2_onu_version")
+}
+
+func TestGetOnuFirmwareInventoryEveryPonFails(t *testing.T) {
+	u, snmpRepository, _ := newTestUsecase()
+	// PONs without OIDs in the test config walk the base OID
+	snmpRepository.failing[testBaseOID2] = true
+	for _, pon := range []config.Board1Pon1{
+		u.cfg.Board1Pon1, config.Board1Pon1(u.cfg.Board1Pon2), config.Board1Pon1(u.cfg.Board2Pon16),
+	} {
+		snmpRepository.failing[testBaseOID2+pon.OnuTypeOID] = true
+	}
+
+	_, err := u.GetOnuFirmwareInventory(context.Background(), "", "")
+	assert.Error(t, err)
+}
+
+// addOnuUniPorts adds the UNI table of ONU 3 on board 1 PON 1 to the fake OLT, each column by UNI port number
+func addOnuUniPorts(u *onuUsecase, snmpRepository *fakeSnmpRepository) (adminOID, linkOID string) {
+	pon := u.cfg.Board1Pon1
+	adminOID = testBaseOID2 + pon.OnuUniAdminStateOID + ".3"
+	linkOID = testBaseOID2 + pon.OnuUniLinkStateOID + ".3"
+	snmpRepository.columns[linkOID] = map[int]interface{}{1: 1, 2: 2, 3: 1}
+	snmpRepository.columns[adminOID] = map[int]interface{}{1: 1, 2: 2} // port 3 is missing
+	snmpRepository.columns[testBaseOID2+pon.OnuUniSpeedOID+".3"] = map[int]interface{}{1: 3, 3: 2, 4: 3}
+	snmpRepository.columns[testBaseOID2+pon.OnuUniDuplexOID+".3"] = map[int]interface{}{1: 2, 2: 2, 3: 1}
+	return adminOID, linkOID
+}
+
+func TestGetOnuUniPorts(t *testing.T) {
+	u, snmpRepository, _ := newTestUsecase()
+	addOnuUniPorts(u, snmpRepository)
+
+	// The columns are joined by port, the link state column defines the ports of the ONU
+	uniPorts, err := u.GetOnuUniPorts(context.Background(), 1, 1, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []model.ONUUniPort{
+		{Board: 1, PON: 1, ID: 3, Port: 1, AdminState: "Unlocked", LinkState: "Up", Speed: "1000M", Duplex: "Full"},
+		{Board: 1, PON: 1, ID: 3, Port: 2, AdminState: "Locked", LinkState: "Down", Speed: "Unknown", Duplex: "Full"},
+		{Board: 1, PON: 1, ID: 3, Port: 3, AdminState: "Unknown", LinkState: "Up", Speed: "100M", Duplex: "Half"},
+	}, uniPorts)
+}
+
+func TestGetOnuUniPortsWalkFails(t *testing.T) {
+	// An optional column that fails is reported as Unknown
+	u, snmpRepository, _ := newTestUsecase()
+	adminOID, linkOID := addOnuUniPorts(u, snmpRepository)
+	snmpRepository.failing[adminOID] = true
+
+	uniPorts, err := u.GetOnuUniPorts(context.Background(), 1, 1, 3)
+	require.NoError(t, err)
+	require.Len(t, uniPorts, 3)
+	for _, uniPort := range uniPorts {
+		assert.Equal(t, "Unknown", uniPort.AdminState)
+	}
+	assert.Equal(t, "1000M", uniPorts[0].Speed)
+
+	// Without the link state the ports of the ONU are not known, the call fails
+	u, snmpRepository, _ = newTestUsecase()
+	_, linkOID = addOnuUniPorts(u, snmpRepository)
+	snmpRepository.failing[linkOID] = true
+
+	_, err = u.GetOnuUniPorts(context.Background(), 1, 1, 3)
+	assert.Error(t, err)
+}
+
+func TestGetOltLocation(t *testing.T) {
+	tests := []struct {
+		name     string
+		timezone string
+		want     string
+	}{
+		{name: "Default", timezone: "", want: "Asia/Jakarta"},
+		{name: "IANA name", timezone: "Asia/Makassar", want: "Asia/Makassar"},
+		{name: "Invalid", timezone: "Mars/Olympus", want: "Asia/Jakarta"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, _, _ := newTestUsecase()
+			u.cfg.OltCfg.Timezone = tt.timezone
+			assert.Equal(t, tt.want, u.GetOltLocation().String())
+		})
+	}
+}
+
+func TestGetOltLocationAutoRetriesDetection(t *testing.T) {
+	u, snmpRepository, _ := newTestUsecase()
+	u.cfg.OltCfg.Timezone = "auto"
+
+	// The OLT does not answer at startup, the default zone is used meanwhile
+	snmpRepository.failing[oltSystemDateOID] = true
+	assert.Equal(t, "Asia/Jakarta", u.GetOltLocation().String())
+
+	// 2024-08-11 10:09:37 +08:00
+	snmpRepository.mu.Lock()
+	delete(snmpRepository.failing, oltSystemDateOID)
+	snmpRepository.values[oltSystemDateOID] = []byte{0x07, 0xe8, 8, 11, 10, 9, 37, 0, '+', 8, 0}
+	snmpRepository.mu.Unlock()
+
+	// Not retried before the retry interval
+	assert.Equal(t, "Asia/Jakarta", u.GetOltLocation().String())
+
+	u.locationAttempt = time.Now().Add(-oltLocationRetryInterval)
+	loc := u.GetOltLocation()
+	_, offset := time.Date(2024, 8, 11, 10, 9, 37, 0, loc).Zone()
+	assert.Equal(t, 8*60*60, offset)
+	assert.Same(t, loc, u.GetOltLocation())
+}
+
+func TestGetOltLocationAutoDoesNotBlockCallers(t *testing.T) {
+	u, snmpRepository, _ := newTestUsecase()
+	u.cfg.OltCfg.Timezone = "auto"
+	u.locationTimeout = 200 * time.Millisecond
+
+	// The OLT is unreachable, like the SNMP repository the Get does not return when its context is done
+	started, release := make(chan struct{}), make(chan struct{})
+	snmpRepository.onGet = func() {
+		close(started)
+		<-release
+	}
+	defer close(release)
+
+	detected := make(chan *time.Location)
+	go func() { detected <- u.GetOltLocation() }()
+	<-started
+
+	// The other callers get the default zone without waiting for the detection
+	other := make(chan *time.Location)
+	go func() { other <- u.GetOltLocation() }()
+	select {
+	case loc := <-other:
+		assert.Equal(t, "Asia/Jakarta", loc.String())
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("GetOltLocation waited for the detection")
+	}
+
+	// The detection gives up after the timeout
+	select {
+	case loc := <-detected:
+		assert.Equal(t, "Asia/Jakarta", loc.String())
+	case <-time.After(2 * time.Second):
+		t.Fatal("the detection did not time out")
+	}
+	assert.False(t, u.locationBusy)
+}
+
+func TestExportOnuInventory(t *testing.T) {
+	for _, detail := range []bool{false, true} {
+		t.Run("detail="+strconv.FormatBool(detail), func(t *testing.T) {
+			u, snmpRepository, redisRepository := newTestUsecase()
+			redisRepository.infoLists["board_1_pon_1"] = []model.ONUInfoPerBoard{
+				{Board: 1, PON: 1, ID: 1, Name: "Isroh", Status: "Online"},
+				{Board: 1, PON: 1, ID: 2, Name: "Budi", Status: "LOS"},
+			}
+
+			var exported []model.ONUCustomerInfo
+			err := u.ExportOnuInventory(context.Background(), 1, 1, model.OnuListFilter{}, detail,
+				func(onuInfo model.ONUCustomerInfo) error {
+					exported = append(exported, onuInfo)
+					return nil
+				})
+			require.NoError(t, err)
+
+			require.Len(t, exported, 2)
+			assert.Equal(t, "Isroh", exported[0].Name)
+			assert.Equal(t, "Budi", exported[1].Name)
+
+			// Without detail nothing is read from the OLT
+			if detail {
+				assert.Positive(t, snmpRepository.gets)
+			} else {
+				assert.Zero(t, snmpRepository.gets)
+			}
+		})
+	}
+}
+
+func TestGetOnuStatusList(t *testing.T) {
+	u, snmpRepository, redisRepository := newTestUsecase()
+	pon := u.cfg.Board1Pon1
+	redisRepository.infoLists["board_1_pon_1"] = []model.ONUInfoPerBoard{
+		{Board: 1, PON: 1, ID: 1, Name: "Isroh", Status: "Online", RXPower: "-20.71"},
+		{Board: 1, PON: 1, ID: 2, Name: "Budi", Status: "Online", RXPower: "-21.00"},
+	}
+
+	// The cached list is older than the status and rx power of the OLT
+	rxPowerOID := testBaseOID1 + pon.OnuRxPowerOID
+	snmpRepository.columns[testBaseOID1+pon.OnuStatusOID] = map[int]interface{}{1: 2, 2: 4}
+	snmpRepository.columns[rxPowerOID] = map[int]interface{}{2: 1000}
+	snmpRepository.suffix[rxPowerOID] = ".1"
+
+	onuInfoList, err := u.GetOnuStatusList(context.Background(), 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []model.ONUInfoPerBoard{
+		{Board: 1, PON: 1, ID: 1, Name: "Isroh", Status: "LOS", RXPower: "-20.71"},
+		{Board: 1, PON: 1, ID: 2, Name: "Budi", Status: "Online", RXPower: "-28.00"},
+	}, onuInfoList)
+	assert.Equal(t, "Online", redisRepository.infoLists["board_1_pon_1"][0].Status, "the cached list is not changed")
+	assert.Zero(t, snmpRepository.walks[testBaseOID1+pon.OnuIDNameOID])
+
+	// A registered ONU reads the list again
+	snmpRepository.columns[testBaseOID1+pon.OnuStatusOID][3] = 4
+	snmpRepository.columns[testBaseOID1+pon.OnuIDNameOID] = map[int]interface{}{1: "Isroh", 2: "Budi", 3: "Sari"}
+
+	onuInfoList, err = u.GetOnuStatusList(context.Background(), 1, 1)
+	require.NoError(t, err)
+	require.Len(t, onuInfoList, 3)
+	assert.Equal(t, "Sari", onuInfoList[2].Name)
+	assert.Equal(t, "Online", onuInfoList[2].Status)
+	assert.Equal(t, 1, snmpRepository.walks[testBaseOID1+pon.OnuIDNameOID])
+	assert.Len(t, redisRepository.infoLists["board_1_pon_1"], 3)
+}
@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracedOnuUsecase starts a span for every usecase call, the SNMP and Redis spans of the call are its children
+type tracedOnuUsecase struct {
+	next OnuUseCaseInterface
+}
+
+// NewTracedOnuUsecase wraps an OnuUseCaseInterface with a span for each method
+func NewTracedOnuUsecase(next OnuUseCaseInterface) OnuUseCaseInterface {
+	return &tracedOnuUsecase{next: next}
+}
+
+// startSpan starts the span of a usecase method
+func startSpan(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "OnuUsecase."+method, attrs...)
+}
+
+// ponAttributes are the span attributes of a board and PON
+func ponAttributes(boardID, ponID int) []attribute.KeyValue {
+	return []attribute.KeyValue{attribute.Int("olt.board", boardID), attribute.Int("olt.pon", ponID)}
+}
+
+// onuAttributes are the span attributes of an ONU
+func onuAttributes(boardID, ponID, onuID int) []attribute.KeyValue {
+	return append(ponAttributes(boardID, ponID), attribute.Int("olt.onu_id", onuID))
+}
+
+func (u *tracedOnuUsecase) GetByBoardIDAndPonID(ctx context.Context, boardID, ponID int) (
+	[]model.ONUInfoPerBoard, error,
+) {
+	ctx, span := startSpan(ctx, "GetByBoardIDAndPonID", ponAttributes(boardID, ponID)...)
+	onuInfoList, err := u.next.GetByBoardIDAndPonID(ctx, boardID, ponID)
+	span.SetAttributes(attribute.Int("olt.onus", len(onuInfoList)))
+	tracing.End(span, err)
+	return onuInfoList, err
+}
+
+func (u *tracedOnuUsecase) GetOnuStatusList(ctx context.Context, boardID, ponID int) (
+	[]model.ONUInfoPerBoard, error,
+) {
+	ctx, span := startSpan(ctx, "GetOnuStatusList", ponAttributes(boardID, ponID)...)
+	onuInfoList, err := u.next.GetOnuStatusList(ctx, boardID, ponID)
+	span.SetAttributes(attribute.Int("olt.onus", len(onuInfoList)))
+	tracing.End(span, err)
+	return onuInfoList, err
+}
+
+func (u *tracedOnuUsecase) GetByBoardIDAndPonIDWithFilter(
+	ctx context.Context, boardID, ponID int, filter model.OnuListFilter,
+) ([]model.ONUInfoPerBoard, error) {
+	ctx, span := startSpan(ctx, "GetByBoardIDAndPonIDWithFilter", ponAttributes(boardID, ponID)...)
+	onuInfoList, err := u.next.GetByBoardIDAndPonIDWithFilter(ctx, boardID, ponID, filter)
+	span.SetAttributes(attribute.Int("olt.onus", len(onuInfoList)))
+	tracing.End(span, err)
+	return onuInfoList, err
+}
+
+func (u *tracedOnuUsecase) GetAllWithFilter(ctx context.Context, filter model.OnuListFilter) (
+	[]model.ONUInfoPerBoard, error,
+) {
+	ctx, span := startSpan(ctx, "GetAllWithFilter")
+	onuInfoList, err := u.next.GetAllWithFilter(ctx, filter)
+	span.SetAttributes(attribute.Int("olt.onus", len(onuInfoList)))
+	tracing.End(span, err)
+	return onuInfoList, err
+}
+
+func (u *tracedOnuUsecase) ExportOnuInventory(
+	ctx context.Context, boardID, ponID int, filter model.OnuListFilter, detail bool,
+	fn func(model.ONUCustomerInfo) error,
+) error {
+	ctx, span := startSpan(ctx, "ExportOnuInventory",
+		append(ponAttributes(boardID, ponID), attribute.Bool("export.detail", detail))...)
+	err := u.next.ExportOnuInventory(ctx, boardID, ponID, filter, detail, fn)
+	tracing.End(span, err)
+	return err
+}
+
+func (u *tracedOnuUsecase) GetByBoardIDPonIDAndOnuID(ctx context.Context, boardID, ponID, onuID int) (
+	model.ONUCustomerInfo, error,
+) {
+	ctx, span := startSpan(ctx, "GetByBoardIDPonIDAndOnuID", onuAttributes(boardID, ponID, onuID)...)
+	onuInfo, err := u.next.GetByBoardIDPonIDAndOnuID(ctx, boardID, ponID, onuID)
+	tracing.End(span, err)
+	return onuInfo, err
+}
+
+func (u *tracedOnuUsecase) GetByBoardIDPonIDAndOnuIDWithFields(
+	ctx context.Context, boardID, ponID, onuID int, fields []string,
+) (model.ONUCustomerInfo, error) {
+	ctx, span := startSpan(ctx, "GetByBoardIDPonIDAndOnuIDWithFields",
+		append(onuAttributes(boardID, ponID, onuID), attribute.StringSlice("olt.fields", fields))...)
+	onuInfo, err := u.next.GetByBoardIDPonIDAndOnuIDWithFields(ctx, boardID, ponID, onuID, fields)
+	tracing.End(span, err)
+	return onuInfo, err
+}
+
+func (u *tracedOnuUsecase) GetOnuUniPorts(ctx context.Context, boardID, ponID, onuID int) (
+	[]model.ONUUniPort, error,
+) {
+	ctx, span := startSpan(ctx, "GetOnuUniPorts", onuAttributes(boardID, ponID, onuID)...)
+	uniPortList, err := u.next.GetOnuUniPorts(ctx, boardID, ponID, onuID)
+	tracing.End(span, err)
+	return uniPortList, err
+}
+
+func (u *tracedOnuUsecase) GetOnuFirmwareInventory(ctx context.Context, onuType, version string) (
+	[]model.OnuFirmwareInventory, error,
+) {
+	ctx, span := startSpan(ctx, "GetOnuFirmwareInventory",
+		attribute.String("olt.onu_type", onuType), attribute.String("olt.version", version))
+	inventoryList, err := u.next.GetOnuFirmwareInventory(ctx, onuType, version)
+	tracing.End(span, err)
+	return inventoryList, err
+}
+
+func (u *tracedOnuUsecase) GetTopTalkers(ctx context.Context, boardID, ponID, limit int, direction string) (
+	[]model.OnuTrafficInfo, error,
+) {
+	ctx, span := startSpan(ctx, "GetTopTalkers", ponAttributes(boardID, ponID)...)
+	trafficList, err := u.next.GetTopTalkers(ctx, boardID, ponID, limit, direction)
+	tracing.End(span, err)
+	return trafficList, err
+}
+
+func (u *tracedOnuUsecase) GetEmptyOnuID(ctx context.Context, boardID, ponID int) ([]model.OnuID, error) {
+	ctx, span := startSpan(ctx, "GetEmptyOnuID", ponAttributes(boardID, ponID)...)
+	onuIDList, err := u.next.GetEmptyOnuID(ctx, boardID, ponID)
+	tracing.End(span, err)
+	return onuIDList, err
+}
+
+func (u *tracedOnuUsecase) GetOnuIDAndSerialNumber(ctx context.Context, boardID, ponID int) (
+	[]model.OnuSerialNumber, error,
+) {
+	ctx, span := startSpan(ctx, "GetOnuIDAndSerialNumber", ponAttributes(boardID, ponID)...)
+	serialNumberList, err := u.next.GetOnuIDAndSerialNumber(ctx, boardID, ponID)
+	tracing.End(span, err)
+	return serialNumberList, err
+}
+
+func (u *tracedOnuUsecase) UpdateEmptyOnuID(ctx context.Context, boardID, ponID int) (
+	before, after []model.OnuID, err error,
+) {
+	ctx, span := startSpan(ctx, "UpdateEmptyOnuID", ponAttributes(boardID, ponID)...)
+	before, after, err = u.next.UpdateEmptyOnuID(ctx, boardID, ponID)
+	tracing.End(span, err)
+	return before, after, err
+}
+
+func (u *tracedOnuUsecase) GetByBoardIDAndPonIDWithPagination(
+	ctx context.Context, boardID, ponID, page, pageSize int,
+) ([]model.ONUInfoPerBoard, int) {
+	ctx, span := startSpan(ctx, "GetByBoardIDAndPonIDWithPagination", ponAttributes(boardID, ponID)...)
+	onuInfoList, count := u.next.GetByBoardIDAndPonIDWithPagination(ctx, boardID, ponID, page, pageSize)
+	span.SetAttributes(attribute.Int("olt.onus", count))
+	tracing.End(span, nil)
+	return onuInfoList, count
+}
+
+// GetOltLocation is resolved once, or retried at most every minute, and does not need a span
+func (u *tracedOnuUsecase) GetOltLocation() *time.Location {
+	return u.next.GetOltLocation()
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/utils"
+)
+
+// Traffic consumers, every consumer computes its rates from its own previous reading of an ONU
+const (
+	TrafficConsumerAPI        = "api"
+	TrafficConsumerExporter   = "exporter"
+	trafficConsumerExport     = "export"
+	trafficConsumerTopTalkers = "top_talkers"
+)
+
+const (
+	// maxTrafficSampleAge is the age after which a previous reading is too old to give a current rate
+	maxTrafficSampleAge = 15 * time.Minute
+
+	// defaultTrafficWait is the time between the two readings of the top talkers without a previous reading
+	defaultTrafficWait = 5 * time.Second
+)
+
+// trafficConsumerKey is the context key of the traffic consumer
+type trafficConsumerKey struct{}
+
+// WithTrafficConsumer returns a context for the usecase calls of a consumer of traffic rates. The rates of a
+// consumer cover the time since its own previous reading of the ONU, so the exporter cycle does not shorten the
+// interval of the API and the other way around. Calls without a consumer are TrafficConsumerAPI.
+func WithTrafficConsumer(ctx context.Context, consumer string) context.Context {
+	return context.WithValue(ctx, trafficConsumerKey{}, consumer)
+}
+
+// trafficConsumer returns the traffic consumer of ctx
+func trafficConsumer(ctx context.Context) string {
+	if consumer, ok := ctx.Value(trafficConsumerKey{}).(string); ok && consumer != "" {
+		return consumer
+	}
+	return TrafficConsumerAPI
+}
+
+// trafficKey identifies the traffic readings of an ONU by a consumer
+type trafficKey struct {
+	consumer string
+	board    int
+	pon      int
+	onu      int
+}
+
+// trafficSample is the last traffic counter reading of an ONU, used to compute rates between polls
+type trafficSample struct {
+	traffic   model.ONUTraffic
+	sampledAt time.Time
+}
+
+// applyTrafficRate fills the rates of the traffic reading from the previous reading of the same ONU by the
+// same consumer and stores the reading for its next poll. A reading older than maxTrafficSampleAge gives no rate.
+func (u *onuUsecase) applyTrafficRate(
+	consumer string, boardID, ponID, onuID int, traffic model.ONUTraffic, sampledAt time.Time,
+) model.ONUTraffic {
+	key := trafficKey{consumer: consumer, board: boardID, pon: ponID, onu: onuID}
+
+	u.trafficMu.Lock()
+	defer u.trafficMu.Unlock()
+
+	if previous, ok := u.trafficSamples[key]; ok && sampledAt.Sub(previous.sampledAt) <= maxTrafficSampleAge {
+		elapsed := sampledAt.Sub(previous.sampledAt)
+		traffic.UpstreamBitRate = utils.CalculateCounterRate(previous.traffic.UpstreamBytes, traffic.UpstreamBytes, elapsed) * 8
+		traffic.DownstreamBitRate = utils.CalculateCounterRate(previous.traffic.DownstreamBytes, traffic.DownstreamBytes, elapsed) * 8
+		traffic.UpstreamPacketRate = utils.CalculateCounterRate(previous.traffic.UpstreamPackets, traffic.UpstreamPackets, elapsed)
+		traffic.DownstreamPacketRate = utils.CalculateCounterRate(previous.traffic.DownstreamPackets, traffic.DownstreamPackets, elapsed)
+		traffic.RateIntervalInSeconds = elapsed.Seconds()
+	}
+
+	u.trafficSamples[key] = trafficSample{traffic: traffic, sampledAt: sampledAt}
+	return traffic
+}
+
+// hasTrafficRate reports whether the consumer has a reading of any of the ONUs that is recent enough for a rate
+func (u *onuUsecase) hasTrafficRate(consumer string, boardID, ponID int, onuIDs []int, now time.Time) bool {
+	u.trafficMu.Lock()
+	defer u.trafficMu.Unlock()
+
+	for _, onuID := range onuIDs {
+		key := trafficKey{consumer: consumer, board: boardID, pon: ponID, onu: onuID}
+		if previous, ok := u.trafficSamples[key]; ok && now.Sub(previous.sampledAt) <= maxTrafficSampleAge {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyTrafficRatePerConsumer(t *testing.T) {
+	u, _, _ := newTestUsecase()
+	start := time.Date(2025, 8, 11, 10, 0, 0, 0, time.UTC)
+	reading := func(bytes uint64) model.ONUTraffic { return model.ONUTraffic{UpstreamBytes: bytes} }
+
+	u.applyTrafficRate(TrafficConsumerAPI, 1, 1, 1, reading(0), start)
+	u.applyTrafficRate(TrafficConsumerExporter, 1, 1, 1, reading(0), start)
+
+	// The exporter cycle does not move the reading of the API
+	exporter := u.applyTrafficRate(TrafficConsumerExporter, 1, 1, 1, reading(1000), start.Add(10*time.Second))
+	assert.Equal(t, 800.0, exporter.UpstreamBitRate)
+	assert.Equal(t, 10.0, exporter.RateIntervalInSeconds)
+
+	api := u.applyTrafficRate(TrafficConsumerAPI, 1, 1, 1, reading(3000), start.Add(20*time.Second))
+	assert.Equal(t, 1200.0, api.UpstreamBitRate)
+	assert.Equal(t, 20.0, api.RateIntervalInSeconds)
+
+	// A reading that is too old gives no rate
+	stale := u.applyTrafficRate(TrafficConsumerAPI, 1, 1, 1, reading(9000), start.Add(time.Hour))
+	assert.Zero(t, stale.UpstreamBitRate)
+	assert.Zero(t, stale.RateIntervalInSeconds)
+}
+
+func TestTrafficConsumerDefaultsToAPI(t *testing.T) {
+	assert.Equal(t, TrafficConsumerAPI, trafficConsumer(context.Background()))
+	assert.Equal(t, TrafficConsumerExporter,
+		trafficConsumer(WithTrafficConsumer(context.Background(), TrafficConsumerExporter)))
+}
+
+func TestGetTopTalkersFirstCallHasRates(t *testing.T) {
+	u, snmpRepository, _ := newTestUsecase()
+	u.trafficWait = 10 * time.Millisecond
+	pon := u.cfg.Board1Pon1
+	snmpRepository.columns[testBaseOID1+pon.OnuIDNameOID] = map[int]interface{}{1: "Isroh", 2: "Budi"}
+
+	// ONU 2 sends 1 MB between two walks of its counters, ONU 1 is idle
+	upstreamOID := testBaseOID2 + pon.OnuUpstreamBytesOID
+	snmpRepository.columns[upstreamOID] = map[int]interface{}{1: uint64(500), 2: uint64(0)}
+	snmpRepository.onWalk = func(oid string) {
+		if oid == upstreamOID {
+			snmpRepository.mu.Lock()
+			snmpRepository.columns[upstreamOID][2] = snmpRepository.columns[upstreamOID][2].(uint64) + 1000000
+			snmpRepository.mu.Unlock()
+		}
+	}
+
+	trafficList, err := u.GetTopTalkers(context.Background(), 1, 1, 0, "upstream")
+	require.NoError(t, err)
+	require.Len(t, trafficList, 2)
+	assert.Equal(t, 2, trafficList[0].ID)
+	assert.Greater(t, trafficList[0].Traffic.UpstreamBitRate, 0.0)
+	assert.Greater(t, trafficList[0].Traffic.RateIntervalInSeconds, 0.0)
+	assert.Zero(t, trafficList[1].Traffic.UpstreamBitRate)
+	assert.Equal(t, 2, snmpRepository.walks[upstreamOID])
+
+	// The next call continues from the reading of the previous call
+	_, err = u.GetTopTalkers(context.Background(), 1, 1, 0, "upstream")
+	require.NoError(t, err)
+	assert.Equal(t, 3, snmpRepository.walks[upstreamOID])
+}
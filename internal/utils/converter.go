@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"time"
+	_ "time/tzdata" // embed the timezone database for LoadLocation in minimal images
 )
 
 // ConvertStringToUint16 Convert String to Uint16
@@ -43,12 +44,24 @@ func ConvertDurationToString(duration time.Duration) string {
 	return strconv.Itoa(days) + " days " + strconv.Itoa(hours) + " hours " + strconv.Itoa(minutes) + " minutes " + strconv.Itoa(seconds) + " seconds"
 }
 
-// ConvertByteArrayToDateTime Convert byte array to human-readable date time
-func ConvertByteArrayToDateTime(byteArray []byte) (string, error) {
+// ConvertByteArrayToDateTime Convert an RFC 2579 DateAndTime byte array to a human-readable date time in the
+// OLT timezone
+func ConvertByteArrayToDateTime(byteArray []byte, loc *time.Location) (string, error) {
+	datetime, err := ParseDateAndTime(byteArray, loc)
+	if err != nil {
+		return "", err
+	}
+
+	return datetime.In(loc).Format("2006-01-02 15:04:05"), nil
+}
+
+// ParseDateAndTime Decode an RFC 2579 DateAndTime byte array. The 11-byte form carries its own UTC offset,
+// the 8-byte form has none and is read as local time in loc
+func ParseDateAndTime(byteArray []byte, loc *time.Location) (time.Time, error) {
 
-	// Check if byteArray length is exactly 8
-	if len(byteArray) != 8 {
-		return "", errors.New("invalid byte array length: expected 8 bytes")
+	// Check if byteArray length is 8 (local time) or 11 (with UTC offset)
+	if len(byteArray) != 8 && len(byteArray) != 11 {
+		return time.Time{}, errors.New("invalid byte array length: expected 8 or 11 bytes")
 	}
 
 	// Extract the year from the first two bytes
@@ -60,27 +73,133 @@ func ConvertByteArrayToDateTime(byteArray []byte) (string, error) {
 	hour := int(byteArray[4])         // Hour
 	minute := int(byteArray[5])       // Minute
 	second := int(byteArray[6])       // Second
+	deciSecond := int(byteArray[7])   // Deci-seconds
 
 	// Validate extracted values
 	if month < 1 || month > 12 {
-		return "", fmt.Errorf("invalid month: %d", month)
+		return time.Time{}, fmt.Errorf("invalid month: %d", month)
 	}
 	if day < 1 || day > 31 {
-		return "", fmt.Errorf("invalid day: %d", day)
+		return time.Time{}, fmt.Errorf("invalid day: %d", day)
 	}
 	if hour < 0 || hour > 23 {
-		return "", fmt.Errorf("invalid hour: %d", hour)
+		return time.Time{}, fmt.Errorf("invalid hour: %d", hour)
 	}
 	if minute < 0 || minute > 59 {
-		return "", fmt.Errorf("invalid minute: %d", minute)
+		return time.Time{}, fmt.Errorf("invalid minute: %d", minute)
 	}
 	if second < 0 || second > 59 {
-		return "", fmt.Errorf("invalid second: %d", second)
+		return time.Time{}, fmt.Errorf("invalid second: %d", second)
+	}
+	if deciSecond < 0 || deciSecond > 9 {
+		return time.Time{}, fmt.Errorf("invalid deci-second: %d", deciSecond)
+	}
+
+	// Without a UTC offset the value is the OLT wall clock
+	location := loc
+	if len(byteArray) == 11 {
+		direction := byteArray[8]           // Direction from UTC, '+' or '-'
+		offsetHours := int(byteArray[9])    // Hours from UTC
+		offsetMinutes := int(byteArray[10]) // Minutes from UTC
+
+		if direction != '+' && direction != '-' {
+			return time.Time{}, fmt.Errorf("invalid UTC offset direction: %q", direction)
+		}
+		if offsetHours > 14 || offsetMinutes > 59 {
+			return time.Time{}, fmt.Errorf("invalid UTC offset: %d:%d", offsetHours, offsetMinutes)
+		}
+
+		offset := offsetHours*3600 + offsetMinutes*60
+		if direction == '-' {
+			offset = -offset
+		}
+		location = time.FixedZone("", offset)
+	}
+
+	return time.Date(year, month, day, hour, minute, second, deciSecond*100*int(time.Millisecond), location), nil
+}
+
+// LoadLocation Load an IANA timezone name (e.g. "Asia/Jakarta") or a fixed UTC offset (e.g. "+07:00")
+func LoadLocation(name string) (*time.Location, error) {
+	if offset, err := time.Parse("-07:00", name); err == nil {
+		_, seconds := offset.Zone()
+		return time.FixedZone("UTC"+name, seconds), nil
+	}
+
+	return time.LoadLocation(name)
+}
+
+// ConvertCounterToUint64 Convert SNMP Counter32/Counter64 value to uint64
+func ConvertCounterToUint64(pduValue interface{}) (uint64, error) {
+	switch v := pduValue.(type) {
+	case uint64:
+		return v, nil
+	case uint32:
+		return uint64(v), nil
+	case uint:
+		return uint64(v), nil
+	case int64:
+		if v < 0 {
+			return 0, fmt.Errorf("negative counter value: %d", v)
+		}
+		return uint64(v), nil
+	case int:
+		if v < 0 {
+			return 0, fmt.Errorf("negative counter value: %d", v)
+		}
+		return uint64(v), nil
+	default:
+		return 0, errors.New("value is not a counter")
+	}
+}
+
+// CalculateCounterRate Calculate the per-second rate between two counter samples.
+// A counter that went backwards (wrap or ONU reboot) or a non-positive interval returns 0.
+func CalculateCounterRate(previous, current uint64, elapsed time.Duration) float64 {
+	if elapsed <= 0 || current < previous {
+		return 0
+	}
+
+	return float64(current-previous) / elapsed.Seconds()
+}
+
+// ParseFloatOrNil Parse a numeric string, returning nil when the value is empty, not a number
+// or an out-of-range reading such as the 65535 sentinel an offline ONU reports
+func ParseFloatOrNil(str string, maxValid float64) *float64 {
+	value, err := strconv.ParseFloat(str, 64)
+	if err != nil || value >= maxValid {
+		return nil
+	}
+
+	return &value
+}
+
+// ParseIntOrNil Parse an integer string, returning nil when the value is empty or not a number
+func ParseIntOrNil(str string) *int {
+	value, err := strconv.Atoi(str)
+	if err != nil {
+		return nil
+	}
+
+	return &value
+}
+
+// StringOrNil Return nil for an empty or "Unknown" string
+func StringOrNil(str string) *string {
+	if str == "" || str == "Unknown" {
+		return nil
 	}
 
-	// Create a time.Time object UTC
-	datetime := time.Date(year, month, day, hour, minute, second, 0, time.UTC)
+	return &str
+}
+
+// ParseDateTimeInLocation Parse a "2006-01-02 15:04:05" date time in the given location, returning nil
+// when the value is empty or invalid
+func ParseDateTimeInLocation(str string, loc *time.Location) *time.Time {
+	value, err := time.ParseInLocation("2006-01-02 15:04:05", str, loc)
+	if err != nil {
+		return nil
+	}
 
-	// Convert to Unix epoch time (seconds since Jan 1, 1970)
-	return datetime.Format("2006-01-02 15:04:05"), nil
+	return &value
 }
@@ -98,6 +98,7 @@ func TestConvertByteArrayToDateTime(t *testing.T) {
 	tests := []struct {
 		name          string
 		byteArray     []byte
+		loc           *time.Location
 		expected      string
 		expectedError bool
 	}{
@@ -106,48 +107,84 @@ func TestConvertByteArrayToDateTime(t *testing.T) {
 			byteArray: []byte{
 				0x07, 0xe4, 0x08, 0x15, 0x0a, 0x1e, 0x00, 0x00,
 			}, // Year 2020, Month 8, Day 21, Hour 10, Minute 30, Second 00
+			loc:           time.UTC,
 			expected:      "2020-08-21 10:30:00",
 			expectedError: false,
 		},
+		{
+			name:          "Local time without UTC offset keeps the OLT wall clock",
+			byteArray:     []byte{0x07, 0xe4, 0x08, 0x15, 0x0a, 0x1e, 0x00, 0x00},
+			loc:           time.FixedZone("WITA", 8*3600),
+			expected:      "2020-08-21 10:30:00",
+			expectedError: false,
+		},
+		{
+			name:          "UTC offset converted to the OLT timezone",
+			byteArray:     []byte{0x07, 0xe4, 0x08, 0x15, 0x03, 0x1e, 0x00, 0x00, '+', 0x00, 0x00},
+			loc:           time.FixedZone("WIB", 7*3600),
+			expected:      "2020-08-21 10:30:00",
+			expectedError: false,
+		},
+		{
+			name:          "Negative UTC offset",
+			byteArray:     []byte{0x07, 0xe4, 0x08, 0x15, 0x0a, 0x1e, 0x00, 0x00, '-', 0x05, 0x1e},
+			loc:           time.UTC,
+			expected:      "2020-08-21 16:00:00",
+			expectedError: false,
+		},
+		{
+			name:          "Invalid UTC offset direction",
+			byteArray:     []byte{0x07, 0xe4, 0x08, 0x15, 0x0a, 0x1e, 0x00, 0x00, 0x00, 0x07, 0x00},
+			loc:           time.UTC,
+			expected:      "",
+			expectedError: true,
+		},
 		{
 			name:          "Invalid month",
 			byteArray:     []byte{0x07, 0xe4, 0x13, 0x15, 0x0a, 0x1e, 0x00, 0x00},
+			loc:           time.UTC,
 			expected:      "",
 			expectedError: true,
 		},
 		{
 			name:          "Invalid day",
 			byteArray:     []byte{0x07, 0xe4, 0x08, 0x32, 0x0a, 0x1e, 0x00, 0x00},
+			loc:           time.UTC,
 			expected:      "",
 			expectedError: true,
 		},
 		{
 			name:          "Invalid hour",
 			byteArray:     []byte{0x07, 0xe4, 0x08, 0x15, 0x18, 0x1e, 0x00, 0x00},
+			loc:           time.UTC,
 			expected:      "",
 			expectedError: true,
 		},
 		{
 			name:          "Invalid minute",
 			byteArray:     []byte{0x07, 0xe4, 0x08, 0x15, 0x0a, 0x3c, 0x00, 0x00},
+			loc:           time.UTC,
 			expected:      "",
 			expectedError: true,
 		},
 		{
 			name:          "Invalid second",
 			byteArray:     []byte{0x07, 0xe4, 0x08, 0x15, 0x0a, 0x1e, 0x3c, 0x00},
+			loc:           time.UTC,
 			expected:      "",
 			expectedError: true,
 		},
 		{
 			name:          "Invalid byte array length",
 			byteArray:     []byte{0x07, 0xe4, 0x08, 0x15, 0x0a, 0x1e, 0x00},
+			loc:           time.UTC,
 			expected:      "",
 			expectedError: true,
 		},
 		{
 			name:          "Extra byte",
 			byteArray:     []byte{0x07, 0xe4, 0x08, 0x15, 0x0a, 0x1e, 0x00, 0x00, 0x01},
+			loc:           time.UTC,
 			expected:      "",
 			expectedError: true,
 		},
@@ -155,7 +192,7 @@ func TestConvertByteArrayToDateTime(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := ConvertByteArrayToDateTime(tt.byteArray)
+			result, err := ConvertByteArrayToDateTime(tt.byteArray, tt.loc)
 			if (err != nil) != tt.expectedError {
 				t.Errorf("ConvertByteArrayToDateTime() error = %v, expectedError %v", err, tt.expectedError)
 				return
@@ -166,3 +203,172 @@ func TestConvertByteArrayToDateTime(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertCounterToUint64(t *testing.T) {
+	testCases := []struct {
+		name     string
+		pduValue interface{}
+		expected uint64
+		err      bool
+	}{
+		{"Counter64", uint64(18446744073709551615), 18446744073709551615, false},
+		{"Counter32", uint(4294967295), 4294967295, false},
+		{"uint32", uint32(42), 42, false},
+		{"int64", int64(42), 42, false},
+		{"int", 42, 42, false},
+		{"Negative int", -1, 0, true},
+		{"Negative int64", int64(-1), 0, true},
+		{"String", "42", 0, true},
+		{"Nil", nil, 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := ConvertCounterToUint64(tc.pduValue)
+			if tc.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestCalculateCounterRate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		previous uint64
+		current  uint64
+		elapsed  time.Duration
+		expected float64
+	}{
+		{"Steady traffic", 1000, 4000, 30 * time.Second, 100},
+		{"No traffic", 1000, 1000, 30 * time.Second, 0},
+		{"Counter reset", 4000, 1000, 30 * time.Second, 0},
+		{"Zero interval", 1000, 4000, 0, 0},
+		{"Negative interval", 1000, 4000, -time.Second, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := CalculateCounterRate(tc.previous, tc.current, tc.elapsed)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestParseFloatOrNil(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		maxValid float64
+		expected *float64
+	}{
+		{"Valid power", "-22.22", 100, floatPtr(-22.22)},
+		{"Zero", "0", 100, floatPtr(0)},
+		{"Sentinel reading", "101.07", 100, nil},
+		{"Empty string", "", 100, nil},
+		{"Unknown", "Unknown", 100, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := ParseFloatOrNil(tc.input, tc.maxValid)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestParseIntOrNil(t *testing.T) {
+	distance := 6701
+
+	testCases := []struct {
+		name     string
+		input    string
+		expected *int
+	}{
+		{"Valid distance", "6701", &distance},
+		{"Unknown", "Unknown", nil},
+		{"Empty string", "", nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := ParseIntOrNil(tc.input)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestStringOrNil(t *testing.T) {
+	value := "10.90.1.214"
+
+	assert.Equal(t, &value, StringOrNil("10.90.1.214"))
+	assert.Nil(t, StringOrNil(""))
+	assert.Nil(t, StringOrNil("Unknown"))
+}
+
+func TestParseDateAndTime(t *testing.T) {
+	wib := time.FixedZone("WIB", 7*3600)
+
+	// 8-byte form is the OLT wall clock
+	result, err := ParseDateAndTime([]byte{0x07, 0xe8, 0x08, 0x0b, 0x0a, 0x09, 0x25, 0x05}, wib)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1723345777), result.Unix())
+	assert.Equal(t, 500*time.Millisecond, time.Duration(result.Nanosecond()))
+
+	// 11-byte form carries its own offset and ignores loc
+	result, err = ParseDateAndTime([]byte{0x07, 0xe8, 0x08, 0x0b, 0x0b, 0x09, 0x25, 0x00, '+', 0x08, 0x00}, wib)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1723345777), result.Unix())
+
+	_, err = ParseDateAndTime([]byte{0x07, 0xe8, 0x08, 0x0b, 0x0a, 0x09, 0x25, 0x0a}, wib)
+	assert.Error(t, err)
+	_, err = ParseDateAndTime([]byte{0x07, 0xe8, 0x08, 0x0b, 0x0a, 0x09, 0x25, 0x00, '+', 0x0f, 0x00}, wib)
+	assert.Error(t, err)
+}
+
+func TestLoadLocation(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		offset   int
+		hasError bool
+	}{
+		{"IANA name", "Asia/Makassar", 8 * 3600, false},
+		{"Positive offset", "+07:00", 7 * 3600, false},
+		{"Negative offset", "-03:30", -(3*3600 + 30*60), false},
+		{"Unknown name", "Mars/Olympus", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := LoadLocation(tt.input)
+			if tt.hasError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			_, offset := time.Date(2024, 1, 1, 0, 0, 0, 0, loc).Zone()
+			assert.Equal(t, tt.offset, offset)
+		})
+	}
+}
+
+func TestParseDateTimeInLocation(t *testing.T) {
+	wib := time.FixedZone("WIB", 7*3600)
+
+	result := ParseDateTimeInLocation("2024-08-11 10:09:37", wib)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, "2024-08-11T10:09:37+07:00", result.Format(time.RFC3339))
+		assert.Equal(t, int64(1723345777), result.Unix())
+	}
+
+	assert.Nil(t, ParseDateTimeInLocation("", wib))
+	assert.Nil(t, ParseDateTimeInLocation("invalid", wib))
+}
+
+func floatPtr(value float64) *float64 {
+	return &value
+}
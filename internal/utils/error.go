@@ -44,3 +44,43 @@ func ErrorNotFound(w http.ResponseWriter, err error) {
 	}
 	SendJSONResponse(w, http.StatusNotFound, webResponse)
 }
+
+// ErrorServiceUnavailable is a helper function to send a 503 Service Unavailable response
+func ErrorServiceUnavailable(w http.ResponseWriter, err error) {
+	webResponse := ErrorResponse{
+		Code:    http.StatusServiceUnavailable,
+		Status:  "Service Unavailable",
+		Message: err.Error(),
+	}
+	SendJSONResponse(w, http.StatusServiceUnavailable, webResponse)
+}
+
+// ErrorUnauthorized is a helper function to send a 401 Unauthorized response
+func ErrorUnauthorized(w http.ResponseWriter, err error) {
+	webResponse := ErrorResponse{
+		Code:    http.StatusUnauthorized,
+		Status:  "Unauthorized",
+		Message: err.Error(),
+	}
+	SendJSONResponse(w, http.StatusUnauthorized, webResponse)
+}
+
+// ErrorForbidden is a helper function to send a 403 Forbidden response
+func ErrorForbidden(w http.ResponseWriter, err error) {
+	webResponse := ErrorResponse{
+		Code:    http.StatusForbidden,
+		Status:  "Forbidden",
+		Message: err.Error(),
+	}
+	SendJSONResponse(w, http.StatusForbidden, webResponse)
+}
+
+// ErrorTooManyRequests is a helper function to send a 429 Too Many Requests response
+func ErrorTooManyRequests(w http.ResponseWriter, err error) {
+	webResponse := ErrorResponse{
+		Code:    http.StatusTooManyRequests,
+		Status:  "Too Many Requests",
+		Message: err.Error(),
+	}
+	SendJSONResponse(w, http.StatusTooManyRequests, webResponse)
+}
@@ -146,3 +146,87 @@ func TestErrorNotFound(t *testing.T) {
 		t.Errorf("Respons JSON tidak sesuai")
 	}
 }
+
+func TestErrorServiceUnavailable(t *testing.T) {
+	rr := httptest.NewRecorder()
+	err := errors.New("Service Unavailable Error")
+	ErrorServiceUnavailable(rr, err)
+
+	// Periksa kode status respons
+	if status := rr.Code; status != http.StatusServiceUnavailable {
+		t.Errorf("Status code tidak sesuai: got %v want %v", status, http.StatusServiceUnavailable)
+	}
+
+	// Periksa pesan kesalahan dalam respons JSON
+	var response ErrorResponse
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Errorf("Gagal mendecode respons JSON: %v", err)
+	}
+
+	if response.Code != http.StatusServiceUnavailable || response.Status != "Service Unavailable" || response.Message != err.Error() {
+		t.Errorf("Respons JSON tidak sesuai")
+	}
+}
+
+func TestErrorUnauthorized(t *testing.T) {
+	rr := httptest.NewRecorder()
+	err := errors.New("Unauthorized Error")
+	ErrorUnauthorized(rr, err)
+
+	// Periksa kode status respons
+	if status := rr.Code; status != http.StatusUnauthorized {
+		t.Errorf("Status code tidak sesuai: got %v want %v", status, http.StatusUnauthorized)
+	}
+
+	// Periksa pesan kesalahan dalam respons JSON
+	var response ErrorResponse
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Errorf("Gagal mendecode respons JSON: %v", err)
+	}
+
+	if response.Code != http.StatusUnauthorized || response.Status != "Unauthorized" || response.Message != err.Error() {
+		t.Errorf("Respons JSON tidak sesuai")
+	}
+}
+
+func TestErrorForbidden(t *testing.T) {
+	rr := httptest.NewRecorder()
+	err := errors.New("Forbidden Error")
+	ErrorForbidden(rr, err)
+
+	// Periksa kode status respons
+	if status := rr.Code; status != http.StatusForbidden {
+		t.Errorf("Status code tidak sesuai: got %v want %v", status, http.StatusForbidden)
+	}
+
+	// Periksa pesan kesalahan dalam respons JSON
+	var response ErrorResponse
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Errorf("Gagal mendecode respons JSON: %v", err)
+	}
+
+	if response.Code != http.StatusForbidden || response.Status != "Forbidden" || response.Message != err.Error() {
+		t.Errorf("Respons JSON tidak sesuai")
+	}
+}
+
+func TestErrorTooManyRequests(t *testing.T) {
+	rr := httptest.NewRecorder()
+	err := errors.New("Too Many Requests Error")
+	ErrorTooManyRequests(rr, err)
+
+	// Periksa kode status respons
+	if status := rr.Code; status != http.StatusTooManyRequests {
+		t.Errorf("Status code tidak sesuai: got %v want %v", status, http.StatusTooManyRequests)
+	}
+
+	// Periksa pesan kesalahan dalam respons JSON
+	var response ErrorResponse
+	if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
+		t.Errorf("Gagal mendecode respons JSON: %v", err)
+	}
+
+	if response.Code != http.StatusTooManyRequests || response.Status != "Too Many Requests" || response.Message != err.Error() {
+		t.Errorf("Respons JSON tidak sesuai")
+	}
+}
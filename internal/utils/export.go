@@ -0,0 +1,231 @@
+package utils
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+)
+
+// Export formats
+const (
+	ExportFormatCSV  = "csv"
+	ExportFormatXLSX = "xlsx"
+)
+
+// RowWriter writes a table row by row to a file format, Close must be called to finish the file
+type RowWriter interface {
+	WriteRow(values []string) error
+	Close() error
+}
+
+// NewRowWriter Create a RowWriter for the export format
+func NewRowWriter(w io.Writer, format string) (RowWriter, error) {
+	switch format {
+	case ExportFormatCSV:
+		return &csvRowWriter{writer: csv.NewWriter(w)}, nil
+	case ExportFormatXLSX:
+		return newXLSXRowWriter(w)
+	default:
+		return nil, errors.New("unknown export format: " + format)
+	}
+}
+
+// ExportContentType Return the content type of the export format
+func ExportContentType(format string) string {
+	if format == ExportFormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// OnuInventoryColumns is the header of the ONU inventory export, in the order of OnuInventoryRow
+var OnuInventoryColumns = []string{
+	"board", "pon", "onu_id", "name", "description", "onu_type", "serial_number",
+	"rx_power", "tx_power", "olt_rx_power", "temperature", "voltage", "bias_current",
+	"status", "ip_address", "last_online", "last_offline", "uptime", "last_down_time_duration",
+	"offline_reason", "gpon_optical_distance", "hardware_version", "software_version_active",
+	"software_version_standby", "equipment_id", "upstream_bytes", "downstream_bytes",
+	"upstream_packets", "downstream_packets",
+}
+
+// OnuListColumns is the header of the ONU inventory export without the detail, in the order of OnuListRow
+var OnuListColumns = []string{
+	"board", "pon", "onu_id", "name", "onu_type", "serial_number", "rx_power", "olt_rx_power", "status",
+}
+
+// OnuListRow Convert the ONU fields of the cached PON list to an export row
+func OnuListRow(onuInfo model.ONUCustomerInfo) []string {
+	return []string{
+		strconv.Itoa(onuInfo.Board), strconv.Itoa(onuInfo.PON), strconv.Itoa(onuInfo.ID),
+		onuInfo.Name, onuInfo.OnuType, onuInfo.SerialNumber, onuInfo.RXPower, onuInfo.OltRXPower, onuInfo.Status,
+	}
+}
+
+// OnuInventoryRow Convert the ONU detail to an export row
+func OnuInventoryRow(onuInfo model.ONUCustomerInfo) []string {
+	return []string{
+		strconv.Itoa(onuInfo.Board), strconv.Itoa(onuInfo.PON), strconv.Itoa(onuInfo.ID),
+		onuInfo.Name, onuInfo.Description, onuInfo.OnuType, onuInfo.SerialNumber,
+		onuInfo.RXPower, onuInfo.TXPower, onuInfo.OltRXPower, onuInfo.Temperature, onuInfo.Voltage, onuInfo.BiasCurrent,
+		onuInfo.Status, onuInfo.IPAddress, onuInfo.LastOnline, onuInfo.LastOffline, onuInfo.Uptime,
+		onuInfo.LastDownTimeDuration, onuInfo.LastOfflineReason, onuInfo.GponOpticalDistance,
+		onuInfo.HardwareVersion, onuInfo.SoftwareVersionActive, onuInfo.SoftwareVersionStandby, onuInfo.EquipmentID,
+		strconv.FormatUint(onuInfo.Traffic.UpstreamBytes, 10), strconv.FormatUint(onuInfo.Traffic.DownstreamBytes, 10),
+		strconv.FormatUint(onuInfo.Traffic.UpstreamPackets, 10), strconv.FormatUint(onuInfo.Traffic.DownstreamPackets, 10),
+	}
+}
+
+// csvRowWriter writes rows as CSV, every row is flushed so the file streams out
+type csvRowWriter struct {
+	writer *csv.Writer
+}
+
+func (c *csvRowWriter) WriteRow(values []string) error {
+	escaped := make([]string, len(values))
+	for i, value := range values {
+		escaped[i] = escapeFormula(value)
+	}
+	if err := c.writer.Write(escaped); err != nil {
+		return err
+	}
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+func (c *csvRowWriter) Close() error {
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+// XLSX package parts, the worksheet is written row by row between xlsxSheetHeader and xlsxSheetFooter
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`
+	xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+	xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="ONU" sheetId="1" r:id="rId1"/></sheets></workbook>`
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`
+	xlsxSheetHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	xlsxSheetFooter = `</sheetData></worksheet>`
+)
+
+// xlsxRowWriter writes a single sheet XLSX workbook. The zip is written sequentially, so the file streams out
+// without being buffered in memory.
+type xlsxRowWriter struct {
+	zipWriter *zip.Writer
+	sheet     io.Writer
+	rowIndex  int
+}
+
+func newXLSXRowWriter(w io.Writer) (*xlsxRowWriter, error) {
+	zipWriter := zip.NewWriter(w)
+
+	parts := []struct{ name, content string }{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/workbook.xml", xlsxWorkbook},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+	}
+	for _, part := range parts {
+		partWriter, err := zipWriter.Create(part.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(partWriter, part.content); err != nil {
+			return nil, err
+		}
+	}
+
+	// The worksheet must be the last part, it stays open until Close
+	sheet, err := zipWriter.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.WriteString(sheet, xlsxSheetHeader); err != nil {
+		return nil, err
+	}
+
+	return &xlsxRowWriter{zipWriter: zipWriter, sheet: sheet}, nil
+}
+
+func (x *xlsxRowWriter) WriteRow(values []string) error {
+	x.rowIndex++
+	rowNumber := strconv.Itoa(x.rowIndex)
+
+	var row strings.Builder
+	row.WriteString(`<row r="` + rowNumber + `">`)
+	for i, value := range values {
+		ref := xlsxColumnName(i) + rowNumber
+		if isXLSXNumber(value) {
+			row.WriteString(`<c r="` + ref + `"><v>` + value + `</v></c>`)
+			continue
+		}
+		row.WriteString(`<c r="` + ref + `" t="inlineStr"><is><t>`)
+		if err := xml.EscapeText(&row, []byte(escapeFormula(value))); err != nil {
+			return err
+		}
+		row.WriteString(`</t></is></c>`)
+	}
+	row.WriteString(`</row>`)
+
+	_, err := io.WriteString(x.sheet, row.String())
+	return err
+}
+
+func (x *xlsxRowWriter) Close() error {
+	if _, err := io.WriteString(x.sheet, xlsxSheetFooter); err != nil {
+		return err
+	}
+	return x.zipWriter.Close()
+}
+
+// xlsxColumnName returns the spreadsheet column name of a zero based column index: A, B, ..., Z, AA, AB, ...
+func xlsxColumnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
+
+// isXLSXNumber returns true when the value is a plain decimal number that keeps its meaning as a number cell.
+// Values with a leading zero such as "007" stay text.
+func isXLSXNumber(value string) bool {
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		return false
+	}
+	digits := strings.TrimPrefix(value, "-")
+	if digits == "" || digits[0] < '0' || digits[0] > '9' {
+		return false // NaN, Inf, +1 and .5 stay text
+	}
+	return !(len(digits) > 1 && digits[0] == '0' && digits[1] != '.')
+}
+
+// escapeFormula prefixes a text value that a spreadsheet would evaluate as a formula with a quote, names and
+// descriptions come from the field. Plain numbers such as "-20.71" are kept.
+func escapeFormula(value string) string {
+	if value == "" || !strings.ContainsRune("=+-@\t\r", rune(value[0])) || isXLSXNumber(value) {
+		return value
+	}
+	return "'" + value
+}
@@ -0,0 +1,139 @@
+package utils
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSVRowWriter(t *testing.T) {
+	var buffer bytes.Buffer
+
+	writer, err := NewRowWriter(&buffer, ExportFormatCSV)
+	assert.NoError(t, err)
+	assert.NoError(t, writer.WriteRow([]string{"board", "name"}))
+	assert.NoError(t, writer.WriteRow([]string{"1", "Isroh, Bale Agung"}))
+	assert.NoError(t, writer.Close())
+
+	assert.Equal(t, "board,name\n1,\"Isroh, Bale Agung\"\n", buffer.String())
+}
+
+func TestXLSXRowWriter(t *testing.T) {
+	var buffer bytes.Buffer
+
+	writer, err := NewRowWriter(&buffer, ExportFormatXLSX)
+	assert.NoError(t, err)
+	assert.NoError(t, writer.WriteRow([]string{"board", "name", "rx_power"}))
+	assert.NoError(t, writer.WriteRow([]string{"1", "Isroh & <Sons>", "-20.71"}))
+	assert.NoError(t, writer.Close())
+
+	reader, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	assert.NoError(t, err)
+
+	files := make(map[string]string)
+	for _, file := range reader.File {
+		content, err := file.Open()
+		assert.NoError(t, err)
+		data, err := io.ReadAll(content)
+		assert.NoError(t, err)
+		files[file.Name] = string(data)
+	}
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels"} {
+		assert.Contains(t, files, name)
+	}
+	assert.Equal(t, xlsxSheetHeader+
+		`<row r="1"><c r="A1" t="inlineStr"><is><t>board</t></is></c><c r="B1" t="inlineStr"><is><t>name</t></is></c>`+
+		`<c r="C1" t="inlineStr"><is><t>rx_power</t></is></c></row>`+
+		`<row r="2"><c r="A2"><v>1</v></c><c r="B2" t="inlineStr"><is><t>Isroh &amp; &lt;Sons&gt;</t></is></c>`+
+		`<c r="C2"><v>-20.71</v></c></row>`+
+		xlsxSheetFooter, files["xl/worksheets/sheet1.xml"])
+}
+
+func TestNewRowWriterUnknownFormat(t *testing.T) {
+	_, err := NewRowWriter(io.Discard, "pdf")
+	assert.Error(t, err)
+}
+
+func TestXLSXColumnName(t *testing.T) {
+	tests := map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 701: "ZZ", 702: "AAA"}
+	for index, expected := range tests {
+		assert.Equal(t, expected, xlsxColumnName(index))
+	}
+}
+
+func TestIsXLSXNumber(t *testing.T) {
+	tests := map[string]bool{
+		"0": true, "42": true, "-20.71": true, "0.5": true,
+		"": false, "007": false, "NaN": false, "Inf": false, "+1": false, ".5": false,
+		"10.90.1.214": false, "ZTEGCEEA1119": false,
+	}
+	for value, expected := range tests {
+		assert.Equal(t, expected, isXLSXNumber(value), value)
+	}
+}
+
+func TestOnuInventoryRow(t *testing.T) {
+	row := OnuInventoryRow(model.ONUCustomerInfo{
+		Board: 2, PON: 7, ID: 4, Name: "Isroh", Status: "Online",
+		Traffic: model.ONUTraffic{UpstreamBytes: 10, DownstreamPackets: 20},
+	})
+
+	assert.Len(t, row, len(OnuInventoryColumns))
+	assert.Equal(t, []string{"2", "7", "4", "Isroh"}, row[:4])
+	assert.Equal(t, "Online", row[13])
+	assert.Equal(t, "10", row[len(row)-4])
+	assert.Equal(t, "20", row[len(row)-1])
+}
+
+func TestEscapeFormula(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"Isroh", "Isroh"},
+		{"", ""},
+		{"-20.71", "-20.71"},
+		{"=HYPERLINK(\"http://evil\")", "'=HYPERLINK(\"http://evil\")"},
+		{"+62 812", "'+62 812"},
+		{"-2+3", "'-2+3"},
+		{"@SUM(A1)", "'@SUM(A1)"},
+		{"\tcmd", "'\tcmd"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, escapeFormula(tt.value), tt.value)
+	}
+}
+
+func TestRowWritersEscapeFormulas(t *testing.T) {
+	var buffer bytes.Buffer
+
+	writer, err := NewRowWriter(&buffer, ExportFormatCSV)
+	assert.NoError(t, err)
+	assert.NoError(t, writer.WriteRow([]string{"1", "=1+1", "-20.71"}))
+	assert.NoError(t, writer.Close())
+	assert.Equal(t, "1,'=1+1,-20.71\n", buffer.String())
+
+	buffer.Reset()
+	writer, err = NewRowWriter(&buffer, ExportFormatXLSX)
+	assert.NoError(t, err)
+	assert.NoError(t, writer.WriteRow([]string{"@cmd"}))
+	assert.NoError(t, writer.Close())
+
+	reader, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	assert.NoError(t, err)
+	for _, file := range reader.File {
+		if file.Name == "xl/worksheets/sheet1.xml" {
+			content, err := file.Open()
+			assert.NoError(t, err)
+			data, err := io.ReadAll(content)
+			assert.NoError(t, err)
+			assert.Contains(t, string(data), "<t>&#39;@cmd</t>")
+		}
+	}
+}
@@ -99,6 +99,19 @@ func ConvertAndMultiply(pduValue interface{}) (string, error) {
 	return resultStr, nil
 }
 
+// ConvertAndScale function is used to convert the PDU value to string after multiplying by the given scale,
+// for readings the OLT reports in thousandths (0.001 dBm, 0.001 °C, mV, µA)
+func ConvertAndScale(pduValue interface{}, scale float64) (string, error) {
+	// Type assert pduValue to an integer type
+	intValue, ok := pduValue.(int)
+	if !ok {
+		return "", fmt.Errorf("value is not an integer")
+	}
+
+	// Convert the result to a string with two decimal places
+	return strconv.FormatFloat(float64(intValue)*scale, 'f', 2, 64), nil
+}
+
 // ExtractAndGetStatus function is used to extract and get status from OID value
 func ExtractAndGetStatus(oidValue interface{}) string {
 	// Check if oidValue is not an integer
@@ -167,6 +180,28 @@ func ExtractLastOfflineReason(oidValue interface{}) string {
 	}
 }
 
+// StatusCode function is used to get the OLT status code (1-7) of a status returned by ExtractAndGetStatus,
+// 0 when the status is Unknown
+func StatusCode(status string) int {
+	for code := 1; code <= 7; code++ {
+		if ExtractAndGetStatus(code) == status {
+			return code
+		}
+	}
+	return 0
+}
+
+// LastOfflineReasonCode function is used to get the OLT code (1-13) of a reason returned by
+// ExtractLastOfflineReason, an unrecognised reason is reported as 1 (Unknown) like the OLT does
+func LastOfflineReasonCode(reason string) int {
+	for code := 1; code <= 13; code++ {
+		if ExtractLastOfflineReason(code) == reason {
+			return code
+		}
+	}
+	return 1
+}
+
 // ExtractGponOpticalDistance function is used to extract GPON optical distance from OID value
 func ExtractGponOpticalDistance(oidValue interface{}) string {
 	// Check if oidValue is not an integer
@@ -177,3 +212,81 @@ func ExtractGponOpticalDistance(oidValue interface{}) string {
 
 	return strconv.Itoa(intValue)
 }
+
+// ExtractUniAdminState function is used to extract ONU UNI port admin state from OID value
+func ExtractUniAdminState(oidValue interface{}) string {
+	// Check if oidValue is not an integer
+	intValue, ok := oidValue.(int)
+	if !ok {
+		return "Unknown"
+	}
+
+	switch intValue {
+	case 1:
+		return "Unlocked"
+	case 2:
+		return "Locked"
+	default:
+		return "Unknown"
+	}
+}
+
+// ExtractUniLinkState function is used to extract ONU UNI port link state from OID value
+func ExtractUniLinkState(oidValue interface{}) string {
+	// Check if oidValue is not an integer
+	intValue, ok := oidValue.(int)
+	if !ok {
+		return "Unknown"
+	}
+
+	switch intValue {
+	case 1:
+		return "Up"
+	case 2:
+		return "Down"
+	default:
+		return "Unknown"
+	}
+}
+
+// ExtractUniSpeed function is used to extract ONU UNI port negotiated speed from OID value
+func ExtractUniSpeed(oidValue interface{}) string {
+	// Check if oidValue is not an integer
+	intValue, ok := oidValue.(int)
+	if !ok {
+		return "Unknown"
+	}
+
+	switch intValue {
+	case 1:
+		return "10M"
+	case 2:
+		return "100M"
+	case 3:
+		return "1000M"
+	case 4:
+		return "10G"
+	case 5:
+		return "2500M"
+	default:
+		return "Unknown"
+	}
+}
+
+// ExtractUniDuplex function is used to extract ONU UNI port duplex mode from OID value
+func ExtractUniDuplex(oidValue interface{}) string {
+	// Check if oidValue is not an integer
+	intValue, ok := oidValue.(int)
+	if !ok {
+		return "Unknown"
+	}
+
+	switch intValue {
+	case 1:
+		return "Half"
+	case 2:
+		return "Full"
+	default:
+		return "Unknown"
+	}
+}
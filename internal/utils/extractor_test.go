@@ -115,6 +115,34 @@ func TestConvertAndMultiply(t *testing.T) {
 	}
 }
 
+func TestConvertAndScale(t *testing.T) {
+	testCases := []struct {
+		pduValue interface{}
+		scale    float64
+		expected string
+		err      bool
+	}{
+		{-22220, 0.001, "-22.22", false},
+		{45500, 0.001, "45.50", false},
+		{3300, 0.001, "3.30", false},
+		{0, 0.001, "0.00", false},
+		{"string", 0.001, "", true},
+		{nil, 0.001, "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("PDUValue: %v", tc.pduValue), func(t *testing.T) {
+			result, err := ConvertAndScale(tc.pduValue, tc.scale)
+			if tc.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
+
 func TestExtractAndGetStatus(t *testing.T) {
 	testCases := []struct {
 		oidValue interface{}
@@ -142,6 +170,21 @@ func TestExtractAndGetStatus(t *testing.T) {
 	}
 }
 
+func TestStatusCode(t *testing.T) {
+	for code := 1; code <= 7; code++ {
+		assert.Equal(t, code, StatusCode(ExtractAndGetStatus(code)))
+	}
+	assert.Equal(t, 0, StatusCode("Unknown"))
+	assert.Equal(t, 0, StatusCode(""))
+}
+
+func TestLastOfflineReasonCode(t *testing.T) {
+	for code := 1; code <= 13; code++ {
+		assert.Equal(t, code, LastOfflineReasonCode(ExtractLastOfflineReason(code)))
+	}
+	assert.Equal(t, 1, LastOfflineReasonCode(""))
+}
+
 // TestExtractLastOfflineReason tests the ExtractLastOfflineReason function.
 func TestExtractLastOfflineReason(t *testing.T) {
 	tests := []struct {
@@ -284,3 +327,83 @@ func TestExtractGponOpticalDistance(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractUniAdminState(t *testing.T) {
+	testCases := []struct {
+		oidValue interface{}
+		expected string
+	}{
+		{1, "Unlocked"},
+		{2, "Locked"},
+		{3, "Unknown"},
+		{"invalid", "Unknown"},
+		{nil, "Unknown"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("OIDValue: %v", tc.oidValue), func(t *testing.T) {
+			result := ExtractUniAdminState(tc.oidValue)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestExtractUniLinkState(t *testing.T) {
+	testCases := []struct {
+		oidValue interface{}
+		expected string
+	}{
+		{1, "Up"},
+		{2, "Down"},
+		{0, "Unknown"},
+		{"invalid", "Unknown"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("OIDValue: %v", tc.oidValue), func(t *testing.T) {
+			result := ExtractUniLinkState(tc.oidValue)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestExtractUniSpeed(t *testing.T) {
+	testCases := []struct {
+		oidValue interface{}
+		expected string
+	}{
+		{1, "10M"},
+		{2, "100M"},
+		{3, "1000M"},
+		{4, "10G"},
+		{5, "2500M"},
+		{6, "Unknown"},
+		{"invalid", "Unknown"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("OIDValue: %v", tc.oidValue), func(t *testing.T) {
+			result := ExtractUniSpeed(tc.oidValue)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestExtractUniDuplex(t *testing.T) {
+	testCases := []struct {
+		oidValue interface{}
+		expected string
+	}{
+		{1, "Half"},
+		{2, "Full"},
+		{3, "Unknown"},
+		{"invalid", "Unknown"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("OIDValue: %v", tc.oidValue), func(t *testing.T) {
+			result := ExtractUniDuplex(tc.oidValue)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+)
+
+// ONU list query parameters
+const (
+	FilterOnuIDVar         = "onu_id"
+	FilterStatusVar        = "status"
+	FilterTypeVar          = "type"
+	FilterNameVar          = "name~"
+	FilterRxLessThanVar    = "rx_lt"
+	FilterRxGreaterThanVar = "rx_gt"
+	FilterSortVar          = "sort"
+)
+
+const (
+	sortDirectionAsc     = "asc"
+	sortDirectionDesc    = "desc"
+	sortFieldSeparator   = ":"
+	filterValueSeparator = ","
+)
+
+// onuListSortFields maps the sort field name to its comparison, a negative result sorts a before b
+var onuListSortFields = map[string]func(a, b model.ONUInfoPerBoard) int{
+	"board":         func(a, b model.ONUInfoPerBoard) int { return a.Board - b.Board },
+	"pon":           func(a, b model.ONUInfoPerBoard) int { return a.PON - b.PON },
+	"onu_id":        func(a, b model.ONUInfoPerBoard) int { return a.ID - b.ID },
+	"name":          func(a, b model.ONUInfoPerBoard) int { return strings.Compare(a.Name, b.Name) },
+	"onu_type":      func(a, b model.ONUInfoPerBoard) int { return strings.Compare(a.OnuType, b.OnuType) },
+	"serial_number": func(a, b model.ONUInfoPerBoard) int { return strings.Compare(a.SerialNumber, b.SerialNumber) },
+	"status":        func(a, b model.ONUInfoPerBoard) int { return strings.Compare(a.Status, b.Status) },
+	"rx_power":      func(a, b model.ONUInfoPerBoard) int { return comparePower(a.RXPower, b.RXPower) },
+	"olt_rx_power":  func(a, b model.ONUInfoPerBoard) int { return comparePower(a.OltRXPower, b.OltRXPower) },
+}
+
+// ParseOnuListFilter Parse the ONU list filter and sort query parameters. Any other parameter returns an error
+// unless it is listed in allowed, e.g. the pagination parameters.
+//
+// Supported parameters: onu_id=1,2  status=LOS,Offline  type=F670LV7.1  name~=text  rx_lt=-27  rx_gt=-8
+// sort=rx_power:asc
+func ParseOnuListFilter(query url.Values, allowed ...string) (model.OnuListFilter, error) {
+	var filter model.OnuListFilter
+
+	for param, values := range query {
+		value := strings.Join(values, filterValueSeparator)
+
+		switch param {
+		case FilterOnuIDVar:
+			for _, item := range splitFilterValues(value) {
+				id, err := strconv.Atoi(item)
+				if err != nil {
+					return model.OnuListFilter{}, fmt.Errorf("invalid '%s' parameter: %s", param, item)
+				}
+				filter.IDs = append(filter.IDs, id)
+			}
+		case FilterStatusVar:
+			filter.Statuses = splitFilterValues(value)
+		case FilterTypeVar:
+			filter.OnuTypes = splitFilterValues(value)
+		case FilterNameVar:
+			filter.NameContains = strings.TrimSpace(values[0])
+		case FilterRxLessThanVar, FilterRxGreaterThanVar:
+			power, err := strconv.ParseFloat(strings.TrimSpace(values[0]), 64)
+			if err != nil {
+				return model.OnuListFilter{}, fmt.Errorf("invalid '%s' parameter: %s", param, values[0])
+			}
+			if param == FilterRxLessThanVar {
+				filter.RxPowerLessThan = &power
+			} else {
+				filter.RxPowerGreaterThan = &power
+			}
+		case FilterSortVar:
+			field, direction, _ := strings.Cut(strings.TrimSpace(values[0]), sortFieldSeparator)
+			if _, ok := onuListSortFields[field]; !ok {
+				return model.OnuListFilter{}, fmt.Errorf("invalid '%s' parameter, unknown field: %s", param, field)
+			}
+			if direction != "" && direction != sortDirectionAsc && direction != sortDirectionDesc {
+				return model.OnuListFilter{}, fmt.Errorf("invalid '%s' parameter, direction must be asc or desc", param)
+			}
+			filter.SortBy = field
+			filter.SortDescending = direction == sortDirectionDesc
+		default:
+			if !slices.Contains(allowed, param) {
+				return model.OnuListFilter{}, fmt.Errorf("invalid query parameter '%s'", param)
+			}
+		}
+	}
+
+	return filter, nil
+}
+
+// FilterOnuList Return the ONUs matching the filter, sorted by the filter sort field. The input is not modified.
+// ONUs without a readable rx power never match an rx power filter and sort last.
+func FilterOnuList(onuInfoList []model.ONUInfoPerBoard, filter model.OnuListFilter) []model.ONUInfoPerBoard {
+	result := make([]model.ONUInfoPerBoard, 0, len(onuInfoList))
+	for _, onuInfo := range onuInfoList {
+		if matchOnuListFilter(onuInfo, filter) {
+			result = append(result, onuInfo)
+		}
+	}
+
+	if compare, ok := onuListSortFields[filter.SortBy]; ok {
+		sort.SliceStable(result, func(i, j int) bool {
+			// Missing power values stay last in both directions
+			if filter.SortBy == "rx_power" || filter.SortBy == "olt_rx_power" {
+				iMissing, jMissing := missingPower(result[i], filter.SortBy), missingPower(result[j], filter.SortBy)
+				if iMissing != jMissing {
+					return jMissing
+				}
+			}
+			if filter.SortDescending {
+				return compare(result[i], result[j]) > 0
+			}
+			return compare(result[i], result[j]) < 0
+		})
+	}
+
+	return result
+}
+
+// matchOnuListFilter returns true when the ONU matches every filter
+func matchOnuListFilter(onuInfo model.ONUInfoPerBoard, filter model.OnuListFilter) bool {
+	if len(filter.IDs) > 0 && !slices.Contains(filter.IDs, onuInfo.ID) {
+		return false
+	}
+	if len(filter.Statuses) > 0 && !containsStringFold(filter.Statuses, onuInfo.Status) {
+		return false
+	}
+	if len(filter.OnuTypes) > 0 && !containsStringFold(filter.OnuTypes, onuInfo.OnuType) {
+		return false
+	}
+	if filter.NameContains != "" &&
+		!strings.Contains(strings.ToLower(onuInfo.Name), strings.ToLower(filter.NameContains)) {
+		return false
+	}
+
+	if filter.RxPowerLessThan != nil || filter.RxPowerGreaterThan != nil {
+		power, err := strconv.ParseFloat(onuInfo.RXPower, 64)
+		if err != nil {
+			return false
+		}
+		if filter.RxPowerLessThan != nil && power >= *filter.RxPowerLessThan {
+			return false
+		}
+		if filter.RxPowerGreaterThan != nil && power <= *filter.RxPowerGreaterThan {
+			return false
+		}
+	}
+
+	return true
+}
+
+// missingPower returns true when the power field used for sorting can not be parsed
+func missingPower(onuInfo model.ONUInfoPerBoard, field string) bool {
+	value := onuInfo.RXPower
+	if field == "olt_rx_power" {
+		value = onuInfo.OltRXPower
+	}
+	_, err := strconv.ParseFloat(value, 64)
+	return err != nil
+}
+
+// comparePower compares two power strings numerically
+func comparePower(a, b string) int {
+	powerA, _ := strconv.ParseFloat(a, 64)
+	powerB, _ := strconv.ParseFloat(b, 64)
+	switch {
+	case powerA < powerB:
+		return -1
+	case powerA > powerB:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// splitFilterValues splits a comma separated filter value, ignoring blanks
+func splitFilterValues(value string) []string {
+	var values []string
+	for _, item := range strings.Split(value, filterValueSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+	return values
+}
+
+// containsStringFold returns true when values contains value, ignoring case
+func containsStringFold(values []string, value string) bool {
+	for _, item := range values {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
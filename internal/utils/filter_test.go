@@ -0,0 +1,113 @@
+package utils
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/megadata-dev/go-snmp-olt-zte-c320/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOnuListFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		allowed  []string
+		expected model.OnuListFilter
+		hasError bool
+	}{
+		{
+			name:     "Empty query",
+			query:    "",
+			expected: model.OnuListFilter{},
+		},
+		{
+			name:  "All filters",
+			query: "onu_id=1,2&status=LOS,Offline&type=F670LV7.1&name~=isroh&rx_lt=-27&rx_gt=-30&sort=rx_power:desc",
+			expected: model.OnuListFilter{
+				IDs:                []int{1, 2},
+				Statuses:           []string{"LOS", "Offline"},
+				OnuTypes:           []string{"F670LV7.1"},
+				NameContains:       "isroh",
+				RxPowerLessThan:    floatPtr(-27),
+				RxPowerGreaterThan: floatPtr(-30),
+				SortBy:             "rx_power",
+				SortDescending:     true,
+			},
+		},
+		{
+			name:     "Sort without direction is ascending",
+			query:    "sort=name",
+			expected: model.OnuListFilter{SortBy: "name"},
+		},
+		{
+			name:     "Allowed pagination parameters",
+			query:    "page=2&limit=5&status=Online",
+			allowed:  []string{"page", "limit"},
+			expected: model.OnuListFilter{Statuses: []string{"Online"}},
+		},
+		{"Unknown parameter", "page=2", nil, model.OnuListFilter{}, true},
+		{"Invalid rx_lt", "rx_lt=low", nil, model.OnuListFilter{}, true},
+		{"Invalid onu_id", "onu_id=1,x", nil, model.OnuListFilter{}, true},
+		{"Unknown sort field", "sort=uptime:asc", nil, model.OnuListFilter{}, true},
+		{"Invalid sort direction", "sort=rx_power:up", nil, model.OnuListFilter{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := url.ParseQuery(tt.query)
+			assert.NoError(t, err)
+
+			result, err := ParseOnuListFilter(query, tt.allowed...)
+			if tt.hasError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestFilterOnuList(t *testing.T) {
+	onuInfoList := []model.ONUInfoPerBoard{
+		{Board: 1, PON: 1, ID: 1, Name: "Isroh", OnuType: "F670LV7.1", RXPower: "-20.71", Status: "Online"},
+		{Board: 1, PON: 1, ID: 2, Name: "Budi", OnuType: "F609V5.3", RXPower: "-28.10", Status: "Online"},
+		{Board: 1, PON: 1, ID: 3, Name: "Sari", OnuType: "F670LV7.1", RXPower: "", Status: "LOS"},
+		{Board: 1, PON: 1, ID: 4, Name: "Isnaini", OnuType: "F670LV7.1", RXPower: "-27.50", Status: "Offline"},
+	}
+
+	ids := func(list []model.ONUInfoPerBoard) []int {
+		result := make([]int, 0, len(list))
+		for _, onuInfo := range list {
+			result = append(result, onuInfo.ID)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name     string
+		filter   model.OnuListFilter
+		expected []int
+	}{
+		{"No filter", model.OnuListFilter{}, []int{1, 2, 3, 4}},
+		{"Status ignores case", model.OnuListFilter{Statuses: []string{"los", "offline"}}, []int{3, 4}},
+		{"Type", model.OnuListFilter{OnuTypes: []string{"F609V5.3"}}, []int{2}},
+		{"Name contains", model.OnuListFilter{NameContains: "is"}, []int{1, 4}},
+		{"Rx less than skips missing power", model.OnuListFilter{RxPowerLessThan: floatPtr(-27)}, []int{2, 4}},
+		{"Rx greater than", model.OnuListFilter{RxPowerGreaterThan: floatPtr(-28)}, []int{1, 4}},
+		{"IDs", model.OnuListFilter{IDs: []int{4, 1}}, []int{1, 4}},
+		{"Sort rx power asc, missing last", model.OnuListFilter{SortBy: "rx_power"}, []int{2, 4, 1, 3}},
+		{"Sort rx power desc, missing last", model.OnuListFilter{SortBy: "rx_power", SortDescending: true}, []int{1, 4, 2, 3}},
+		{"Sort name desc", model.OnuListFilter{SortBy: "name", SortDescending: true}, []int{3, 1, 4, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ids(FilterOnuList(onuInfoList, tt.filter)))
+		})
+	}
+
+	// The input keeps its order
+	assert.Equal(t, []int{1, 2, 3, 4}, ids(onuInfoList))
+}
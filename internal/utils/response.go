@@ -1,5 +1,10 @@
 package utils
 
+import (
+	"encoding/json"
+	"strings"
+)
+
 // WebResponse defines the structure for standard web responses
 type WebResponse struct {
 	Code   int32       `json:"code"`
@@ -13,3 +18,40 @@ type ErrorResponse struct {
 	Status  string      `json:"status"`
 	Message interface{} `json:"message"`
 }
+
+// SelectJSONFields Marshal data and keep only the given JSON fields, the board, pon and onu_id keys are always kept
+func SelectJSONFields(data interface{}, fields []string) (map[string]json.RawMessage, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &all); err != nil {
+		return nil, err
+	}
+
+	selected := make(map[string]json.RawMessage)
+	for _, key := range append([]string{"board", "pon", "onu_id"}, fields...) {
+		if value, ok := all[key]; ok {
+			selected[key] = value
+		}
+	}
+
+	return selected, nil
+}
+
+// ParseFieldsQuery Split a comma separated fields query value, ignoring blanks and duplicates
+func ParseFieldsQuery(value string) []string {
+	var fields []string
+	seen := make(map[string]bool)
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		if field != "" && !seen[field] {
+			seen[field] = true
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
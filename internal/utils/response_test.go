@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
 )
 
@@ -80,3 +81,42 @@ func TestErrorBadRequestBos(t *testing.T) {
 		t.Errorf("Respons JSON tidak sesuai: got %+v want %+v", decodedResponse, expectedResponse)
 	}
 }
+
+func TestSelectJSONFields(t *testing.T) {
+	data := struct {
+		Board  int    `json:"board"`
+		PON    int    `json:"pon"`
+		ID     int    `json:"onu_id"`
+		Name   string `json:"name"`
+		Status string `json:"status"`
+	}{Board: 1, PON: 8, ID: 11, Name: "customer", Status: "Online"}
+
+	selected, err := SelectJSONFields(data, []string{"status", "unknown"})
+	if err != nil {
+		t.Fatalf("SelectJSONFields returned error: %v", err)
+	}
+
+	encoded, _ := json.Marshal(selected)
+	expected := `{"board":1,"onu_id":11,"pon":8,"status":"Online"}`
+	if string(encoded) != expected {
+		t.Errorf("SelectJSONFields() = %s, want %s", encoded, expected)
+	}
+}
+
+func TestParseFieldsQuery(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"", nil},
+		{"status", []string{"status"}},
+		{" status , rx_power,,status,tx_power ", []string{"status", "rx_power", "tx_power"}},
+	}
+
+	for _, tt := range tests {
+		result := ParseFieldsQuery(tt.input)
+		if !reflect.DeepEqual(result, tt.expected) {
+			t.Errorf("ParseFieldsQuery(%q) = %v, want %v", tt.input, result, tt.expected)
+		}
+	}
+}
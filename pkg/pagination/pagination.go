@@ -66,3 +66,22 @@ func parseInt(value string, defaultValue int) int {
 	}
 	return defaultValue
 }
+
+// Slice returns the items of the given page, an empty slice when the page is out of range
+func Slice[T any](items []T, page, pageSize int) []T {
+	if page <= 0 || pageSize <= 0 {
+		return []T{}
+	}
+
+	startIndex := (page - 1) * pageSize
+	if startIndex >= len(items) {
+		return []T{}
+	}
+
+	endIndex := startIndex + pageSize
+	if endIndex > len(items) {
+		endIndex = len(items)
+	}
+
+	return items[startIndex:endIndex]
+}
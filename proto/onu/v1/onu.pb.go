@@ -0,0 +1,1468 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: onu/v1/onu.proto
+
+package onuv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Onu is an ONU of a PON list
+type Onu struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Board         int32                  `protobuf:"varint,1,opt,name=board,proto3" json:"board,omitempty"`
+	Pon           int32                  `protobuf:"varint,2,opt,name=pon,proto3" json:"pon,omitempty"`
+	OnuId         int32                  `protobuf:"varint,3,opt,name=onu_id,json=onuId,proto3" json:"onu_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	OnuType       string                 `protobuf:"bytes,5,opt,name=onu_type,json=onuType,proto3" json:"onu_type,omitempty"`
+	SerialNumber  string                 `protobuf:"bytes,6,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	RxPower       string                 `protobuf:"bytes,7,opt,name=rx_power,json=rxPower,proto3" json:"rx_power,omitempty"`
+	OltRxPower    string                 `protobuf:"bytes,8,opt,name=olt_rx_power,json=oltRxPower,proto3" json:"olt_rx_power,omitempty"`
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Onu) Reset() {
+	*x = Onu{}
+	mi := &file_onu_v1_onu_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Onu) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Onu) ProtoMessage() {}
+
+func (x *Onu) ProtoReflect() protoreflect.Message {
+	mi := &file_onu_v1_onu_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Onu.ProtoReflect.Descriptor instead.
+func (*Onu) Descriptor() ([]byte, []int) {
+	return file_onu_v1_onu_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Onu) GetBoard() int32 {
+	if x != nil {
+		return x.Board
+	}
+	return 0
+}
+
+func (x *Onu) GetPon() int32 {
+	if x != nil {
+		return x.Pon
+	}
+	return 0
+}
+
+func (x *Onu) GetOnuId() int32 {
+	if x != nil {
+		return x.OnuId
+	}
+	return 0
+}
+
+func (x *Onu) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Onu) GetOnuType() string {
+	if x != nil {
+		return x.OnuType
+	}
+	return ""
+}
+
+func (x *Onu) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *Onu) GetRxPower() string {
+	if x != nil {
+		return x.RxPower
+	}
+	return ""
+}
+
+func (x *Onu) GetOltRxPower() string {
+	if x != nil {
+		return x.OltRxPower
+	}
+	return ""
+}
+
+func (x *Onu) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// OnuTraffic is the ONU traffic counters and the rates computed between two polls
+type OnuTraffic struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UpstreamBytes       uint64                 `protobuf:"varint,1,opt,name=upstream_bytes,json=upstreamBytes,proto3" json:"upstream_bytes,omitempty"`
+	DownstreamBytes     uint64                 `protobuf:"varint,2,opt,name=downstream_bytes,json=downstreamBytes,proto3" json:"downstream_bytes,omitempty"`
+	UpstreamPackets     uint64                 `protobuf:"varint,3,opt,name=upstream_packets,json=upstreamPackets,proto3" json:"upstream_packets,omitempty"`
+	DownstreamPackets   uint64                 `protobuf:"varint,4,opt,name=downstream_packets,json=downstreamPackets,proto3" json:"downstream_packets,omitempty"`
+	UpstreamBps         float64                `protobuf:"fixed64,5,opt,name=upstream_bps,json=upstreamBps,proto3" json:"upstream_bps,omitempty"`
+	DownstreamBps       float64                `protobuf:"fixed64,6,opt,name=downstream_bps,json=downstreamBps,proto3" json:"downstream_bps,omitempty"`
+	UpstreamPps         float64                `protobuf:"fixed64,7,opt,name=upstream_pps,json=upstreamPps,proto3" json:"upstream_pps,omitempty"`
+	DownstreamPps       float64                `protobuf:"fixed64,8,opt,name=downstream_pps,json=downstreamPps,proto3" json:"downstream_pps,omitempty"`
+	RateIntervalSeconds float64                `protobuf:"fixed64,9,opt,name=rate_interval_seconds,json=rateIntervalSeconds,proto3" json:"rate_interval_seconds,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *OnuTraffic) Reset() {
+	*x = OnuTraffic{}
+	mi := &file_onu_v1_onu_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnuTraffic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnuTraffic) ProtoMessage() {}
+
+func (x *OnuTraffic) ProtoReflect() protoreflect.Message {
+	mi := &file_onu_v1_onu_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnuTraffic.ProtoReflect.Descriptor instead.
+func (*OnuTraffic) Descriptor() ([]byte, []int) {
+	return file_onu_v1_onu_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OnuTraffic) GetUpstreamBytes() uint64 {
+	if x != nil {
+		return x.UpstreamBytes
+	}
+	return 0
+}
+
+func (x *OnuTraffic) GetDownstreamBytes() uint64 {
+	if x != nil {
+		return x.DownstreamBytes
+	}
+	return 0
+}
+
+func (x *OnuTraffic) GetUpstreamPackets() uint64 {
+	if x != nil {
+		return x.UpstreamPackets
+	}
+	return 0
+}
+
+func (x *OnuTraffic) GetDownstreamPackets() uint64 {
+	if x != nil {
+		return x.DownstreamPackets
+	}
+	return 0
+}
+
+func (x *OnuTraffic) GetUpstreamBps() float64 {
+	if x != nil {
+		return x.UpstreamBps
+	}
+	return 0
+}
+
+func (x *OnuTraffic) GetDownstreamBps() float64 {
+	if x != nil {
+		return x.DownstreamBps
+	}
+	return 0
+}
+
+func (x *OnuTraffic) GetUpstreamPps() float64 {
+	if x != nil {
+		return x.UpstreamPps
+	}
+	return 0
+}
+
+func (x *OnuTraffic) GetDownstreamPps() float64 {
+	if x != nil {
+		return x.DownstreamPps
+	}
+	return 0
+}
+
+func (x *OnuTraffic) GetRateIntervalSeconds() float64 {
+	if x != nil {
+		return x.RateIntervalSeconds
+	}
+	return 0
+}
+
+// OnuDetail is the detailed information of a single ONU
+type OnuDetail struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Board                  int32                  `protobuf:"varint,1,opt,name=board,proto3" json:"board,omitempty"`
+	Pon                    int32                  `protobuf:"varint,2,opt,name=pon,proto3" json:"pon,omitempty"`
+	OnuId                  int32                  `protobuf:"varint,3,opt,name=onu_id,json=onuId,proto3" json:"onu_id,omitempty"`
+	Name                   string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description            string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	OnuType                string                 `protobuf:"bytes,6,opt,name=onu_type,json=onuType,proto3" json:"onu_type,omitempty"`
+	SerialNumber           string                 `protobuf:"bytes,7,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	RxPower                string                 `protobuf:"bytes,8,opt,name=rx_power,json=rxPower,proto3" json:"rx_power,omitempty"`
+	TxPower                string                 `protobuf:"bytes,9,opt,name=tx_power,json=txPower,proto3" json:"tx_power,omitempty"`
+	OltRxPower             string                 `protobuf:"bytes,10,opt,name=olt_rx_power,json=oltRxPower,proto3" json:"olt_rx_power,omitempty"`
+	Temperature            string                 `protobuf:"bytes,11,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	Voltage                string                 `protobuf:"bytes,12,opt,name=voltage,proto3" json:"voltage,omitempty"`
+	BiasCurrent            string                 `protobuf:"bytes,13,opt,name=bias_current,json=biasCurrent,proto3" json:"bias_current,omitempty"`
+	Status                 string                 `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"`
+	IpAddress              string                 `protobuf:"bytes,15,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	LastOnline             string                 `protobuf:"bytes,16,opt,name=last_online,json=lastOnline,proto3" json:"last_online,omitempty"`
+	LastOffline            string                 `protobuf:"bytes,17,opt,name=last_offline,json=lastOffline,proto3" json:"last_offline,omitempty"`
+	Uptime                 string                 `protobuf:"bytes,18,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	LastDownTimeDuration   string                 `protobuf:"bytes,19,opt,name=last_down_time_duration,json=lastDownTimeDuration,proto3" json:"last_down_time_duration,omitempty"`
+	OfflineReason          string                 `protobuf:"bytes,20,opt,name=offline_reason,json=offlineReason,proto3" json:"offline_reason,omitempty"`
+	GponOpticalDistance    string                 `protobuf:"bytes,21,opt,name=gpon_optical_distance,json=gponOpticalDistance,proto3" json:"gpon_optical_distance,omitempty"`
+	HardwareVersion        string                 `protobuf:"bytes,22,opt,name=hardware_version,json=hardwareVersion,proto3" json:"hardware_version,omitempty"`
+	SoftwareVersionActive  string                 `protobuf:"bytes,23,opt,name=software_version_active,json=softwareVersionActive,proto3" json:"software_version_active,omitempty"`
+	SoftwareVersionStandby string                 `protobuf:"bytes,24,opt,name=software_version_standby,json=softwareVersionStandby,proto3" json:"software_version_standby,omitempty"`
+	EquipmentId            string                 `protobuf:"bytes,25,opt,name=equipment_id,json=equipmentId,proto3" json:"equipment_id,omitempty"`
+	Traffic                *OnuTraffic            `protobuf:"bytes,26,opt,name=traffic,proto3" json:"traffic,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *OnuDetail) Reset() {
+	*x = OnuDetail{}
+	mi := &file_onu_v1_onu_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnuDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnuDetail) ProtoMessage() {}
+
+func (x *OnuDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_onu_v1_onu_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnuDetail.ProtoReflect.Descriptor instead.
+func (*OnuDetail) Descriptor() ([]byte, []int) {
+	return file_onu_v1_onu_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OnuDetail) GetBoard() int32 {
+	if x != nil {
+		return x.Board
+	}
+	return 0
+}
+
+func (x *OnuDetail) GetPon() int32 {
+	if x != nil {
+		return x.Pon
+	}
+	return 0
+}
+
+func (x *OnuDetail) GetOnuId() int32 {
+	if x != nil {
+		return x.OnuId
+	}
+	return 0
+}
+
+func (x *OnuDetail) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OnuDetail) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OnuDetail) GetOnuType() string {
+	if x != nil {
+		return x.OnuType
+	}
+	return ""
+}
+
+func (x *OnuDetail) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *OnuDetail) GetRxPower() string {
+	if x != nil {
+		return x.RxPower
+	}
+	return ""
+}
+
+func (x *OnuDetail) GetTxPower() string {
+	if x != nil {
+		return x.TxPower
+	}
+	return ""
+}
+
+func (x *OnuDetail) GetOltRxPower() string {
+	if x != nil {
+		return x.OltRxPower
+	}
+	return ""
+}
+
+func (x *OnuDetail) GetTemperature() string {
+	if x != nil {
+		return x.Temperature
+	}
+	return ""
+}
+
+func (x *OnuDetail) GetVoltage() string {
+	if x != nil {
+		return x.Voltage
+	}
+	return ""
+}
+
+func (x *OnuDetail) GetBiasCurrent() string {
+	if x != nil {
+		return x.BiasCurrent
+	}
+	return ""
+}
+
+func (x *OnuDetail) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OnuDetail) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *OnuDetail) GetLastOnline() string {
+	if x != nil {
+		return x.LastOnline
+	}
+	return ""
+}
+
+func (x *OnuDetail) GetLastOffline() string {
+	if x != nil {
+		return x.LastOffline
+	}
+	return ""
+}
+
+func (x *OnuDetail) GetUptime() string {
+	if x != nil {
+		return x.Uptime
+	}
+	return ""
+}
+
+func (x *OnuDetail) GetLastDownTimeDuration() string {
+	if x != nil {
+		return x.LastDownTimeDuration
+	}
+	return ""
+}
+
+func (x *OnuDetail) GetOfflineReason() string {
+	if x != nil {
+		return x.OfflineReason
+	}
+	return ""
+}
+
+func (x *OnuDetail) GetGponOpticalDistance() string {
+	if x != nil {
+		return x.GponOpticalDistance
+	}
+	return ""
+}
+
+func (x *OnuDetail) GetHardwareVersion() string {
+	if x != nil {
+		return x.HardwareVersion
+	}
+	return ""
+}
+
+func (x *OnuDetail) GetSoftwareVersionActive() string {
+	if x != nil {
+		return x.SoftwareVersionActive
+	}
+	return ""
+}
+
+func (x *OnuDetail) GetSoftwareVersionStandby() string {
+	if x != nil {
+		return x.SoftwareVersionStandby
+	}
+	return ""
+}
+
+func (x *OnuDetail) GetEquipmentId() string {
+	if x != nil {
+		return x.EquipmentId
+	}
+	return ""
+}
+
+func (x *OnuDetail) GetTraffic() *OnuTraffic {
+	if x != nil {
+		return x.Traffic
+	}
+	return nil
+}
+
+// OnuSerialNumber is the serial number of an ONU
+type OnuSerialNumber struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Board         int32                  `protobuf:"varint,1,opt,name=board,proto3" json:"board,omitempty"`
+	Pon           int32                  `protobuf:"varint,2,opt,name=pon,proto3" json:"pon,omitempty"`
+	OnuId         int32                  `protobuf:"varint,3,opt,name=onu_id,json=onuId,proto3" json:"onu_id,omitempty"`
+	SerialNumber  string                 `protobuf:"bytes,4,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnuSerialNumber) Reset() {
+	*x = OnuSerialNumber{}
+	mi := &file_onu_v1_onu_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnuSerialNumber) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnuSerialNumber) ProtoMessage() {}
+
+func (x *OnuSerialNumber) ProtoReflect() protoreflect.Message {
+	mi := &file_onu_v1_onu_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnuSerialNumber.ProtoReflect.Descriptor instead.
+func (*OnuSerialNumber) Descriptor() ([]byte, []int) {
+	return file_onu_v1_onu_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OnuSerialNumber) GetBoard() int32 {
+	if x != nil {
+		return x.Board
+	}
+	return 0
+}
+
+func (x *OnuSerialNumber) GetPon() int32 {
+	if x != nil {
+		return x.Pon
+	}
+	return 0
+}
+
+func (x *OnuSerialNumber) GetOnuId() int32 {
+	if x != nil {
+		return x.OnuId
+	}
+	return 0
+}
+
+func (x *OnuSerialNumber) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+type ListOnusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Board         int32                  `protobuf:"varint,1,opt,name=board,proto3" json:"board,omitempty"`
+	Pon           int32                  `protobuf:"varint,2,opt,name=pon,proto3" json:"pon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOnusRequest) Reset() {
+	*x = ListOnusRequest{}
+	mi := &file_onu_v1_onu_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOnusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOnusRequest) ProtoMessage() {}
+
+func (x *ListOnusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_onu_v1_onu_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOnusRequest.ProtoReflect.Descriptor instead.
+func (*ListOnusRequest) Descriptor() ([]byte, []int) {
+	return file_onu_v1_onu_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListOnusRequest) GetBoard() int32 {
+	if x != nil {
+		return x.Board
+	}
+	return 0
+}
+
+func (x *ListOnusRequest) GetPon() int32 {
+	if x != nil {
+		return x.Pon
+	}
+	return 0
+}
+
+type ListOnusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Onus          []*Onu                 `protobuf:"bytes,1,rep,name=onus,proto3" json:"onus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOnusResponse) Reset() {
+	*x = ListOnusResponse{}
+	mi := &file_onu_v1_onu_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOnusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOnusResponse) ProtoMessage() {}
+
+func (x *ListOnusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_onu_v1_onu_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOnusResponse.ProtoReflect.Descriptor instead.
+func (*ListOnusResponse) Descriptor() ([]byte, []int) {
+	return file_onu_v1_onu_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListOnusResponse) GetOnus() []*Onu {
+	if x != nil {
+		return x.Onus
+	}
+	return nil
+}
+
+type GetOnuRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Board int32                  `protobuf:"varint,1,opt,name=board,proto3" json:"board,omitempty"`
+	Pon   int32                  `protobuf:"varint,2,opt,name=pon,proto3" json:"pon,omitempty"`
+	OnuId int32                  `protobuf:"varint,3,opt,name=onu_id,json=onuId,proto3" json:"onu_id,omitempty"`
+	// fields limits the detail to the given fields, as the 'fields' query parameter of the REST API. Empty returns
+	// every field.
+	Fields        []string `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnuRequest) Reset() {
+	*x = GetOnuRequest{}
+	mi := &file_onu_v1_onu_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnuRequest) ProtoMessage() {}
+
+func (x *GetOnuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_onu_v1_onu_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnuRequest.ProtoReflect.Descriptor instead.
+func (*GetOnuRequest) Descriptor() ([]byte, []int) {
+	return file_onu_v1_onu_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetOnuRequest) GetBoard() int32 {
+	if x != nil {
+		return x.Board
+	}
+	return 0
+}
+
+func (x *GetOnuRequest) GetPon() int32 {
+	if x != nil {
+		return x.Pon
+	}
+	return 0
+}
+
+func (x *GetOnuRequest) GetOnuId() int32 {
+	if x != nil {
+		return x.OnuId
+	}
+	return 0
+}
+
+func (x *GetOnuRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type GetOnuResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Onu           *OnuDetail             `protobuf:"bytes,1,opt,name=onu,proto3" json:"onu,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnuResponse) Reset() {
+	*x = GetOnuResponse{}
+	mi := &file_onu_v1_onu_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnuResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnuResponse) ProtoMessage() {}
+
+func (x *GetOnuResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_onu_v1_onu_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnuResponse.ProtoReflect.Descriptor instead.
+func (*GetOnuResponse) Descriptor() ([]byte, []int) {
+	return file_onu_v1_onu_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetOnuResponse) GetOnu() *OnuDetail {
+	if x != nil {
+		return x.Onu
+	}
+	return nil
+}
+
+type ListEmptyOnuIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Board         int32                  `protobuf:"varint,1,opt,name=board,proto3" json:"board,omitempty"`
+	Pon           int32                  `protobuf:"varint,2,opt,name=pon,proto3" json:"pon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmptyOnuIDsRequest) Reset() {
+	*x = ListEmptyOnuIDsRequest{}
+	mi := &file_onu_v1_onu_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmptyOnuIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmptyOnuIDsRequest) ProtoMessage() {}
+
+func (x *ListEmptyOnuIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_onu_v1_onu_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmptyOnuIDsRequest.ProtoReflect.Descriptor instead.
+func (*ListEmptyOnuIDsRequest) Descriptor() ([]byte, []int) {
+	return file_onu_v1_onu_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListEmptyOnuIDsRequest) GetBoard() int32 {
+	if x != nil {
+		return x.Board
+	}
+	return 0
+}
+
+func (x *ListEmptyOnuIDsRequest) GetPon() int32 {
+	if x != nil {
+		return x.Pon
+	}
+	return 0
+}
+
+type ListEmptyOnuIDsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OnuIds        []int32                `protobuf:"varint,1,rep,packed,name=onu_ids,json=onuIds,proto3" json:"onu_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmptyOnuIDsResponse) Reset() {
+	*x = ListEmptyOnuIDsResponse{}
+	mi := &file_onu_v1_onu_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmptyOnuIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmptyOnuIDsResponse) ProtoMessage() {}
+
+func (x *ListEmptyOnuIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_onu_v1_onu_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmptyOnuIDsResponse.ProtoReflect.Descriptor instead.
+func (*ListEmptyOnuIDsResponse) Descriptor() ([]byte, []int) {
+	return file_onu_v1_onu_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListEmptyOnuIDsResponse) GetOnuIds() []int32 {
+	if x != nil {
+		return x.OnuIds
+	}
+	return nil
+}
+
+type ListOnuSerialNumbersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Board         int32                  `protobuf:"varint,1,opt,name=board,proto3" json:"board,omitempty"`
+	Pon           int32                  `protobuf:"varint,2,opt,name=pon,proto3" json:"pon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOnuSerialNumbersRequest) Reset() {
+	*x = ListOnuSerialNumbersRequest{}
+	mi := &file_onu_v1_onu_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOnuSerialNumbersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOnuSerialNumbersRequest) ProtoMessage() {}
+
+func (x *ListOnuSerialNumbersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_onu_v1_onu_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOnuSerialNumbersRequest.ProtoReflect.Descriptor instead.
+func (*ListOnuSerialNumbersRequest) Descriptor() ([]byte, []int) {
+	return file_onu_v1_onu_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListOnuSerialNumbersRequest) GetBoard() int32 {
+	if x != nil {
+		return x.Board
+	}
+	return 0
+}
+
+func (x *ListOnuSerialNumbersRequest) GetPon() int32 {
+	if x != nil {
+		return x.Pon
+	}
+	return 0
+}
+
+type ListOnuSerialNumbersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SerialNumbers []*OnuSerialNumber     `protobuf:"bytes,1,rep,name=serial_numbers,json=serialNumbers,proto3" json:"serial_numbers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOnuSerialNumbersResponse) Reset() {
+	*x = ListOnuSerialNumbersResponse{}
+	mi := &file_onu_v1_onu_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOnuSerialNumbersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOnuSerialNumbersResponse) ProtoMessage() {}
+
+func (x *ListOnuSerialNumbersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_onu_v1_onu_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOnuSerialNumbersResponse.ProtoReflect.Descriptor instead.
+func (*ListOnuSerialNumbersResponse) Descriptor() ([]byte, []int) {
+	return file_onu_v1_onu_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListOnuSerialNumbersResponse) GetSerialNumbers() []*OnuSerialNumber {
+	if x != nil {
+		return x.SerialNumbers
+	}
+	return nil
+}
+
+type ListOnusPaginatedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Board int32                  `protobuf:"varint,1,opt,name=board,proto3" json:"board,omitempty"`
+	Pon   int32                  `protobuf:"varint,2,opt,name=pon,proto3" json:"pon,omitempty"`
+	// page starts at 1, 0 means the first page
+	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// limit is the page size, 0 means the default of 10, the maximum is 100
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOnusPaginatedRequest) Reset() {
+	*x = ListOnusPaginatedRequest{}
+	mi := &file_onu_v1_onu_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOnusPaginatedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOnusPaginatedRequest) ProtoMessage() {}
+
+func (x *ListOnusPaginatedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_onu_v1_onu_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOnusPaginatedRequest.ProtoReflect.Descriptor instead.
+func (*ListOnusPaginatedRequest) Descriptor() ([]byte, []int) {
+	return file_onu_v1_onu_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListOnusPaginatedRequest) GetBoard() int32 {
+	if x != nil {
+		return x.Board
+	}
+	return 0
+}
+
+func (x *ListOnusPaginatedRequest) GetPon() int32 {
+	if x != nil {
+		return x.Pon
+	}
+	return 0
+}
+
+func (x *ListOnusPaginatedRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListOnusPaginatedRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListOnusPaginatedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Onus          []*Onu                 `protobuf:"bytes,1,rep,name=onus,proto3" json:"onus,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageCount     int32                  `protobuf:"varint,4,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	TotalRows     int32                  `protobuf:"varint,5,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOnusPaginatedResponse) Reset() {
+	*x = ListOnusPaginatedResponse{}
+	mi := &file_onu_v1_onu_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOnusPaginatedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOnusPaginatedResponse) ProtoMessage() {}
+
+func (x *ListOnusPaginatedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_onu_v1_onu_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOnusPaginatedResponse.ProtoReflect.Descriptor instead.
+func (*ListOnusPaginatedResponse) Descriptor() ([]byte, []int) {
+	return file_onu_v1_onu_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListOnusPaginatedResponse) GetOnus() []*Onu {
+	if x != nil {
+		return x.Onus
+	}
+	return nil
+}
+
+func (x *ListOnusPaginatedResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListOnusPaginatedResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListOnusPaginatedResponse) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+func (x *ListOnusPaginatedResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+type WatchOnuStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Board int32                  `protobuf:"varint,1,opt,name=board,proto3" json:"board,omitempty"`
+	Pon   int32                  `protobuf:"varint,2,opt,name=pon,proto3" json:"pon,omitempty"`
+	// interval_seconds is ignored, the changes come from the event watcher that polls every PON
+	IntervalSeconds int32 `protobuf:"varint,3,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchOnuStatusRequest) Reset() {
+	*x = WatchOnuStatusRequest{}
+	mi := &file_onu_v1_onu_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchOnuStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOnuStatusRequest) ProtoMessage() {}
+
+func (x *WatchOnuStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_onu_v1_onu_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOnuStatusRequest.ProtoReflect.Descriptor instead.
+func (*WatchOnuStatusRequest) Descriptor() ([]byte, []int) {
+	return file_onu_v1_onu_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WatchOnuStatusRequest) GetBoard() int32 {
+	if x != nil {
+		return x.Board
+	}
+	return 0
+}
+
+func (x *WatchOnuStatusRequest) GetPon() int32 {
+	if x != nil {
+		return x.Pon
+	}
+	return 0
+}
+
+func (x *WatchOnuStatusRequest) GetIntervalSeconds() int32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+// OnuStatusEvent is the status of an ONU. previous_status is empty for the first status of an ONU and status is
+// empty when the ONU is removed.
+type OnuStatusEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Board          int32                  `protobuf:"varint,1,opt,name=board,proto3" json:"board,omitempty"`
+	Pon            int32                  `protobuf:"varint,2,opt,name=pon,proto3" json:"pon,omitempty"`
+	OnuId          int32                  `protobuf:"varint,3,opt,name=onu_id,json=onuId,proto3" json:"onu_id,omitempty"`
+	Name           string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	SerialNumber   string                 `protobuf:"bytes,5,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	PreviousStatus string                 `protobuf:"bytes,7,opt,name=previous_status,json=previousStatus,proto3" json:"previous_status,omitempty"`
+	Time           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OnuStatusEvent) Reset() {
+	*x = OnuStatusEvent{}
+	mi := &file_onu_v1_onu_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnuStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnuStatusEvent) ProtoMessage() {}
+
+func (x *OnuStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_onu_v1_onu_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnuStatusEvent.ProtoReflect.Descriptor instead.
+func (*OnuStatusEvent) Descriptor() ([]byte, []int) {
+	return file_onu_v1_onu_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *OnuStatusEvent) GetBoard() int32 {
+	if x != nil {
+		return x.Board
+	}
+	return 0
+}
+
+func (x *OnuStatusEvent) GetPon() int32 {
+	if x != nil {
+		return x.Pon
+	}
+	return 0
+}
+
+func (x *OnuStatusEvent) GetOnuId() int32 {
+	if x != nil {
+		return x.OnuId
+	}
+	return 0
+}
+
+func (x *OnuStatusEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OnuStatusEvent) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *OnuStatusEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OnuStatusEvent) GetPreviousStatus() string {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return ""
+}
+
+func (x *OnuStatusEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+var File_onu_v1_onu_proto protoreflect.FileDescriptor
+
+const file_onu_v1_onu_proto_rawDesc = "" +
+	"\n" +
+	"\x10onu/v1/onu.proto\x12\x06onu.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xed\x01\n" +
+	"\x03Onu\x12\x14\n" +
+	"\x05board\x18\x01 \x01(\x05R\x05board\x12\x10\n" +
+	"\x03pon\x18\x02 \x01(\x05R\x03pon\x12\x15\n" +
+	"\x06onu_id\x18\x03 \x01(\x05R\x05onuId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x19\n" +
+	"\bonu_type\x18\x05 \x01(\tR\aonuType\x12#\n" +
+	"\rserial_number\x18\x06 \x01(\tR\fserialNumber\x12\x19\n" +
+	"\brx_power\x18\a \x01(\tR\arxPower\x12 \n" +
+	"\folt_rx_power\x18\b \x01(\tR\n" +
+	"oltRxPower\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\"\x80\x03\n" +
+	"\n" +
+	"OnuTraffic\x12%\n" +
+	"\x0eupstream_bytes\x18\x01 \x01(\x04R\rupstreamBytes\x12)\n" +
+	"\x10downstream_bytes\x18\x02 \x01(\x04R\x0fdownstreamBytes\x12)\n" +
+	"\x10upstream_packets\x18\x03 \x01(\x04R\x0fupstreamPackets\x12-\n" +
+	"\x12downstream_packets\x18\x04 \x01(\x04R\x11downstreamPackets\x12!\n" +
+	"\fupstream_bps\x18\x05 \x01(\x01R\vupstreamBps\x12%\n" +
+	"\x0edownstream_bps\x18\x06 \x01(\x01R\rdownstreamBps\x12!\n" +
+	"\fupstream_pps\x18\a \x01(\x01R\vupstreamPps\x12%\n" +
+	"\x0edownstream_pps\x18\b \x01(\x01R\rdownstreamPps\x122\n" +
+	"\x15rate_interval_seconds\x18\t \x01(\x01R\x13rateIntervalSeconds\"\x8a\a\n" +
+	"\tOnuDetail\x12\x14\n" +
+	"\x05board\x18\x01 \x01(\x05R\x05board\x12\x10\n" +
+	"\x03pon\x18\x02 \x01(\x05R\x03pon\x12\x15\n" +
+	"\x06onu_id\x18\x03 \x01(\x05R\x05onuId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x19\n" +
+	"\bonu_type\x18\x06 \x01(\tR\aonuType\x12#\n" +
+	"\rserial_number\x18\a \x01(\tR\fserialNumber\x12\x19\n" +
+	"\brx_power\x18\b \x01(\tR\arxPower\x12\x19\n" +
+	"\btx_power\x18\t \x01(\tR\atxPower\x12 \n" +
+	"\folt_rx_power\x18\n" +
+	" \x01(\tR\n" +
+	"oltRxPower\x12 \n" +
+	"\vtemperature\x18\v \x01(\tR\vtemperature\x12\x18\n" +
+	"\avoltage\x18\f \x01(\tR\avoltage\x12!\n" +
+	"\fbias_current\x18\r \x01(\tR\vbiasCurrent\x12\x16\n" +
+	"\x06status\x18\x0e \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x0f \x01(\tR\tipAddress\x12\x1f\n" +
+	"\vlast_online\x18\x10 \x01(\tR\n" +
+	"lastOnline\x12!\n" +
+	"\flast_offline\x18\x11 \x01(\tR\vlastOffline\x12\x16\n" +
+	"\x06uptime\x18\x12 \x01(\tR\x06uptime\x125\n" +
+	"\x17last_down_time_duration\x18\x13 \x01(\tR\x14lastDownTimeDuration\x12%\n" +
+	"\x0eoffline_reason\x18\x14 \x01(\tR\rofflineReason\x122\n" +
+	"\x15gpon_optical_distance\x18\x15 \x01(\tR\x13gponOpticalDistance\x12)\n" +
+	"\x10hardware_version\x18\x16 \x01(\tR\x0fhardwareVersion\x126\n" +
+	"\x17software_version_active\x18\x17 \x01(\tR\x15softwareVersionActive\x128\n" +
+	"\x18software_version_standby\x18\x18 \x01(\tR\x16softwareVersionStandby\x12!\n" +
+	"\fequipment_id\x18\x19 \x01(\tR\vequipmentId\x12,\n" +
+	"\atraffic\x18\x1a \x01(\v2\x12.onu.v1.OnuTrafficR\atraffic\"u\n" +
+	"\x0fOnuSerialNumber\x12\x14\n" +
+	"\x05board\x18\x01 \x01(\x05R\x05board\x12\x10\n" +
+	"\x03pon\x18\x02 \x01(\x05R\x03pon\x12\x15\n" +
+	"\x06onu_id\x18\x03 \x01(\x05R\x05onuId\x12#\n" +
+	"\rserial_number\x18\x04 \x01(\tR\fserialNumber\"9\n" +
+	"\x0fListOnusRequest\x12\x14\n" +
+	"\x05board\x18\x01 \x01(\x05R\x05board\x12\x10\n" +
+	"\x03pon\x18\x02 \x01(\x05R\x03pon\"3\n" +
+	"\x10ListOnusResponse\x12\x1f\n" +
+	"\x04onus\x18\x01 \x03(\v2\v.onu.v1.OnuR\x04onus\"f\n" +
+	"\rGetOnuRequest\x12\x14\n" +
+	"\x05board\x18\x01 \x01(\x05R\x05board\x12\x10\n" +
+	"\x03pon\x18\x02 \x01(\x05R\x03pon\x12\x15\n" +
+	"\x06onu_id\x18\x03 \x01(\x05R\x05onuId\x12\x16\n" +
+	"\x06fields\x18\x04 \x03(\tR\x06fields\"5\n" +
+	"\x0eGetOnuResponse\x12#\n" +
+	"\x03onu\x18\x01 \x01(\v2\x11.onu.v1.OnuDetailR\x03onu\"@\n" +
+	"\x16ListEmptyOnuIDsRequest\x12\x14\n" +
+	"\x05board\x18\x01 \x01(\x05R\x05board\x12\x10\n" +
+	"\x03pon\x18\x02 \x01(\x05R\x03pon\"2\n" +
+	"\x17ListEmptyOnuIDsResponse\x12\x17\n" +
+	"\aonu_ids\x18\x01 \x03(\x05R\x06onuIds\"E\n" +
+	"\x1bListOnuSerialNumbersRequest\x12\x14\n" +
+	"\x05board\x18\x01 \x01(\x05R\x05board\x12\x10\n" +
+	"\x03pon\x18\x02 \x01(\x05R\x03pon\"^\n" +
+	"\x1cListOnuSerialNumbersResponse\x12>\n" +
+	"\x0eserial_numbers\x18\x01 \x03(\v2\x17.onu.v1.OnuSerialNumberR\rserialNumbers\"l\n" +
+	"\x18ListOnusPaginatedRequest\x12\x14\n" +
+	"\x05board\x18\x01 \x01(\x05R\x05board\x12\x10\n" +
+	"\x03pon\x18\x02 \x01(\x05R\x03pon\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xa4\x01\n" +
+	"\x19ListOnusPaginatedResponse\x12\x1f\n" +
+	"\x04onus\x18\x01 \x03(\v2\v.onu.v1.OnuR\x04onus\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_count\x18\x04 \x01(\x05R\tpageCount\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x05 \x01(\x05R\ttotalRows\"j\n" +
+	"\x15WatchOnuStatusRequest\x12\x14\n" +
+	"\x05board\x18\x01 \x01(\x05R\x05board\x12\x10\n" +
+	"\x03pon\x18\x02 \x01(\x05R\x03pon\x12)\n" +
+	"\x10interval_seconds\x18\x03 \x01(\x05R\x0fintervalSeconds\"\xf9\x01\n" +
+	"\x0eOnuStatusEvent\x12\x14\n" +
+	"\x05board\x18\x01 \x01(\x05R\x05board\x12\x10\n" +
+	"\x03pon\x18\x02 \x01(\x05R\x03pon\x12\x15\n" +
+	"\x06onu_id\x18\x03 \x01(\x05R\x05onuId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12#\n" +
+	"\rserial_number\x18\x05 \x01(\tR\fserialNumber\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12'\n" +
+	"\x0fprevious_status\x18\a \x01(\tR\x0epreviousStatus\x12.\n" +
+	"\x04time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x04time2\xe0\x03\n" +
+	"\n" +
+	"OnuService\x12=\n" +
+	"\bListOnus\x12\x17.onu.v1.ListOnusRequest\x1a\x18.onu.v1.ListOnusResponse\x127\n" +
+	"\x06GetOnu\x12\x15.onu.v1.GetOnuRequest\x1a\x16.onu.v1.GetOnuResponse\x12R\n" +
+	"\x0fListEmptyOnuIDs\x12\x1e.onu.v1.ListEmptyOnuIDsRequest\x1a\x1f.onu.v1.ListEmptyOnuIDsResponse\x12a\n" +
+	"\x14ListOnuSerialNumbers\x12#.onu.v1.ListOnuSerialNumbersRequest\x1a$.onu.v1.ListOnuSerialNumbersResponse\x12X\n" +
+	"\x11ListOnusPaginated\x12 .onu.v1.ListOnusPaginatedRequest\x1a!.onu.v1.ListOnusPaginatedResponse\x12I\n" +
+	"\x0eWatchOnuStatus\x12\x1d.onu.v1.WatchOnuStatusRequest\x1a\x16.onu.v1.OnuStatusEvent0\x01BAZ?github.com/megadata-dev/go-snmp-olt-zte-c320/proto/onu/v1;onuv1b\x06proto3"
+
+var (
+	file_onu_v1_onu_proto_rawDescOnce sync.Once
+	file_onu_v1_onu_proto_rawDescData []byte
+)
+
+func file_onu_v1_onu_proto_rawDescGZIP() []byte {
+	file_onu_v1_onu_proto_rawDescOnce.Do(func() {
+		file_onu_v1_onu_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_onu_v1_onu_proto_rawDesc), len(file_onu_v1_onu_proto_rawDesc)))
+	})
+	return file_onu_v1_onu_proto_rawDescData
+}
+
+var file_onu_v1_onu_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_onu_v1_onu_proto_goTypes = []any{
+	(*Onu)(nil),                          // 0: onu.v1.Onu
+	(*OnuTraffic)(nil),                   // 1: onu.v1.OnuTraffic
+	(*OnuDetail)(nil),                    // 2: onu.v1.OnuDetail
+	(*OnuSerialNumber)(nil),              // 3: onu.v1.OnuSerialNumber
+	(*ListOnusRequest)(nil),              // 4: onu.v1.ListOnusRequest
+	(*ListOnusResponse)(nil),             // 5: onu.v1.ListOnusResponse
+	(*GetOnuRequest)(nil),                // 6: onu.v1.GetOnuRequest
+	(*GetOnuResponse)(nil),               // 7: onu.v1.GetOnuResponse
+	(*ListEmptyOnuIDsRequest)(nil),       // 8: onu.v1.ListEmptyOnuIDsRequest
+	(*ListEmptyOnuIDsResponse)(nil),      // 9: onu.v1.ListEmptyOnuIDsResponse
+	(*ListOnuSerialNumbersRequest)(nil),  // 10: onu.v1.ListOnuSerialNumbersRequest
+	(*ListOnuSerialNumbersResponse)(nil), // 11: onu.v1.ListOnuSerialNumbersResponse
+	(*ListOnusPaginatedRequest)(nil),     // 12: onu.v1.ListOnusPaginatedRequest
+	(*ListOnusPaginatedResponse)(nil),    // 13: onu.v1.ListOnusPaginatedResponse
+	(*WatchOnuStatusRequest)(nil),        // 14: onu.v1.WatchOnuStatusRequest
+	(*OnuStatusEvent)(nil),               // 15: onu.v1.OnuStatusEvent
+	(*timestamppb.Timestamp)(nil),        // 16: google.protobuf.Timestamp
+}
+var file_onu_v1_onu_proto_depIdxs = []int32{
+	1,  // 0: onu.v1.OnuDetail.traffic:type_name -> onu.v1.OnuTraffic
+	0,  // 1: onu.v1.ListOnusResponse.onus:type_name -> onu.v1.Onu
+	2,  // 2: onu.v1.GetOnuResponse.onu:type_name -> onu.v1.OnuDetail
+	3,  // 3: onu.v1.ListOnuSerialNumbersResponse.serial_numbers:type_name -> onu.v1.OnuSerialNumber
+	0,  // 4: onu.v1.ListOnusPaginatedResponse.onus:type_name -> onu.v1.Onu
+	16, // 5: onu.v1.OnuStatusEvent.time:type_name -> google.protobuf.Timestamp
+	4,  // 6: onu.v1.OnuService.ListOnus:input_type -> onu.v1.ListOnusRequest
+	6,  // 7: onu.v1.OnuService.GetOnu:input_type -> onu.v1.GetOnuRequest
+	8,  // 8: onu.v1.OnuService.ListEmptyOnuIDs:input_type -> onu.v1.ListEmptyOnuIDsRequest
+	10, // 9: onu.v1.OnuService.ListOnuSerialNumbers:input_type -> onu.v1.ListOnuSerialNumbersRequest
+	12, // 10: onu.v1.OnuService.ListOnusPaginated:input_type -> onu.v1.ListOnusPaginatedRequest
+	14, // 11: onu.v1.OnuService.WatchOnuStatus:input_type -> onu.v1.WatchOnuStatusRequest
+	5,  // 12: onu.v1.OnuService.ListOnus:output_type -> onu.v1.ListOnusResponse
+	7,  // 13: onu.v1.OnuService.GetOnu:output_type -> onu.v1.GetOnuResponse
+	9,  // 14: onu.v1.OnuService.ListEmptyOnuIDs:output_type -> onu.v1.ListEmptyOnuIDsResponse
+	11, // 15: onu.v1.OnuService.ListOnuSerialNumbers:output_type -> onu.v1.ListOnuSerialNumbersResponse
+	13, // 16: onu.v1.OnuService.ListOnusPaginated:output_type -> onu.v1.ListOnusPaginatedResponse
+	15, // 17: onu.v1.OnuService.WatchOnuStatus:output_type -> onu.v1.OnuStatusEvent
+	12, // [12:18] is the sub-list for method output_type
+	6,  // [6:12] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_onu_v1_onu_proto_init() }
+func file_onu_v1_onu_proto_init() {
+	if File_onu_v1_onu_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_onu_v1_onu_proto_rawDesc), len(file_onu_v1_onu_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_onu_v1_onu_proto_goTypes,
+		DependencyIndexes: file_onu_v1_onu_proto_depIdxs,
+		MessageInfos:      file_onu_v1_onu_proto_msgTypes,
+	}.Build()
+	File_onu_v1_onu_proto = out.File
+	file_onu_v1_onu_proto_goTypes = nil
+	file_onu_v1_onu_proto_depIdxs = nil
+}
@@ -0,0 +1,335 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: onu/v1/onu.proto
+
+package onuv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	OnuService_ListOnus_FullMethodName             = "/onu.v1.OnuService/ListOnus"
+	OnuService_GetOnu_FullMethodName               = "/onu.v1.OnuService/GetOnu"
+	OnuService_ListEmptyOnuIDs_FullMethodName      = "/onu.v1.OnuService/ListEmptyOnuIDs"
+	OnuService_ListOnuSerialNumbers_FullMethodName = "/onu.v1.OnuService/ListOnuSerialNumbers"
+	OnuService_ListOnusPaginated_FullMethodName    = "/onu.v1.OnuService/ListOnusPaginated"
+	OnuService_WatchOnuStatus_FullMethodName       = "/onu.v1.OnuService/WatchOnuStatus"
+)
+
+// OnuServiceClient is the client API for OnuService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// OnuService exposes the ONU operations of the REST API over gRPC. Board ID is 1 or 2, PON ID is between 1 and 16
+// and ONU ID is between 1 and 128, an ID out of range returns INVALID_ARGUMENT.
+type OnuServiceClient interface {
+	// ListOnus returns the ONUs of a PON, the same data as GET /api/v1/board/{board_id}/pon/{pon_id}
+	ListOnus(ctx context.Context, in *ListOnusRequest, opts ...grpc.CallOption) (*ListOnusResponse, error)
+	// GetOnu returns the detail of a single ONU, the same data as GET /api/v1/board/{board_id}/pon/{pon_id}/onu/{onu_id}
+	GetOnu(ctx context.Context, in *GetOnuRequest, opts ...grpc.CallOption) (*GetOnuResponse, error)
+	// ListEmptyOnuIDs returns the free ONU IDs of a PON
+	ListEmptyOnuIDs(ctx context.Context, in *ListEmptyOnuIDsRequest, opts ...grpc.CallOption) (*ListEmptyOnuIDsResponse, error)
+	// ListOnuSerialNumbers returns the ONU IDs and serial numbers of a PON
+	ListOnuSerialNumbers(ctx context.Context, in *ListOnuSerialNumbersRequest, opts ...grpc.CallOption) (*ListOnuSerialNumbersResponse, error)
+	// ListOnusPaginated returns a page of the ONUs of a PON
+	ListOnusPaginated(ctx context.Context, in *ListOnusPaginatedRequest, opts ...grpc.CallOption) (*ListOnusPaginatedResponse, error)
+	// WatchOnuStatus streams the status of every ONU of a PON once, then an event every time an ONU status changes,
+	// an ONU is added or an ONU is removed
+	WatchOnuStatus(ctx context.Context, in *WatchOnuStatusRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OnuStatusEvent], error)
+}
+
+type onuServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOnuServiceClient(cc grpc.ClientConnInterface) OnuServiceClient {
+	return &onuServiceClient{cc}
+}
+
+func (c *onuServiceClient) ListOnus(ctx context.Context, in *ListOnusRequest, opts ...grpc.CallOption) (*ListOnusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOnusResponse)
+	err := c.cc.Invoke(ctx, OnuService_ListOnus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onuServiceClient) GetOnu(ctx context.Context, in *GetOnuRequest, opts ...grpc.CallOption) (*GetOnuResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOnuResponse)
+	err := c.cc.Invoke(ctx, OnuService_GetOnu_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onuServiceClient) ListEmptyOnuIDs(ctx context.Context, in *ListEmptyOnuIDsRequest, opts ...grpc.CallOption) (*ListEmptyOnuIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmptyOnuIDsResponse)
+	err := c.cc.Invoke(ctx, OnuService_ListEmptyOnuIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onuServiceClient) ListOnuSerialNumbers(ctx context.Context, in *ListOnuSerialNumbersRequest, opts ...grpc.CallOption) (*ListOnuSerialNumbersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOnuSerialNumbersResponse)
+	err := c.cc.Invoke(ctx, OnuService_ListOnuSerialNumbers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onuServiceClient) ListOnusPaginated(ctx context.Context, in *ListOnusPaginatedRequest, opts ...grpc.CallOption) (*ListOnusPaginatedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOnusPaginatedResponse)
+	err := c.cc.Invoke(ctx, OnuService_ListOnusPaginated_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *onuServiceClient) WatchOnuStatus(ctx context.Context, in *WatchOnuStatusRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OnuStatusEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OnuService_ServiceDesc.Streams[0], OnuService_WatchOnuStatus_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchOnuStatusRequest, OnuStatusEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OnuService_WatchOnuStatusClient = grpc.ServerStreamingClient[OnuStatusEvent]
+
+// OnuServiceServer is the server API for OnuService service.
+// All implementations must embed UnimplementedOnuServiceServer
+// for forward compatibility.
+//
+// OnuService exposes the ONU operations of the REST API over gRPC. Board ID is 1 or 2, PON ID is between 1 and 16
+// and ONU ID is between 1 and 128, an ID out of range returns INVALID_ARGUMENT.
+type OnuServiceServer interface {
+	// ListOnus returns the ONUs of a PON, the same data as GET /api/v1/board/{board_id}/pon/{pon_id}
+	ListOnus(context.Context, *ListOnusRequest) (*ListOnusResponse, error)
+	// GetOnu returns the detail of a single ONU, the same data as GET /api/v1/board/{board_id}/pon/{pon_id}/onu/{onu_id}
+	GetOnu(context.Context, *GetOnuRequest) (*GetOnuResponse, error)
+	// ListEmptyOnuIDs returns the free ONU IDs of a PON
+	ListEmptyOnuIDs(context.Context, *ListEmptyOnuIDsRequest) (*ListEmptyOnuIDsResponse, error)
+	// ListOnuSerialNumbers returns the ONU IDs and serial numbers of a PON
+	ListOnuSerialNumbers(context.Context, *ListOnuSerialNumbersRequest) (*ListOnuSerialNumbersResponse, error)
+	// ListOnusPaginated returns a page of the ONUs of a PON
+	ListOnusPaginated(context.Context, *ListOnusPaginatedRequest) (*ListOnusPaginatedResponse, error)
+	// WatchOnuStatus streams the status of every ONU of a PON once, then an event every time an ONU status changes,
+	// an ONU is added or an ONU is removed
+	WatchOnuStatus(*WatchOnuStatusRequest, grpc.ServerStreamingServer[OnuStatusEvent]) error
+	mustEmbedUnimplementedOnuServiceServer()
+}
+
+// UnimplementedOnuServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOnuServiceServer struct{}
+
+func (UnimplementedOnuServiceServer) ListOnus(context.Context, *ListOnusRequest) (*ListOnusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOnus not implemented")
+}
+func (UnimplementedOnuServiceServer) GetOnu(context.Context, *GetOnuRequest) (*GetOnuResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOnu not implemented")
+}
+func (UnimplementedOnuServiceServer) ListEmptyOnuIDs(context.Context, *ListEmptyOnuIDsRequest) (*ListEmptyOnuIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmptyOnuIDs not implemented")
+}
+func (UnimplementedOnuServiceServer) ListOnuSerialNumbers(context.Context, *ListOnuSerialNumbersRequest) (*ListOnuSerialNumbersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOnuSerialNumbers not implemented")
+}
+func (UnimplementedOnuServiceServer) ListOnusPaginated(context.Context, *ListOnusPaginatedRequest) (*ListOnusPaginatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOnusPaginated not implemented")
+}
+func (UnimplementedOnuServiceServer) WatchOnuStatus(*WatchOnuStatusRequest, grpc.ServerStreamingServer[OnuStatusEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOnuStatus not implemented")
+}
+func (UnimplementedOnuServiceServer) mustEmbedUnimplementedOnuServiceServer() {}
+func (UnimplementedOnuServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeOnuServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OnuServiceServer will
+// result in compilation errors.
+type UnsafeOnuServiceServer interface {
+	mustEmbedUnimplementedOnuServiceServer()
+}
+
+func RegisterOnuServiceServer(s grpc.ServiceRegistrar, srv OnuServiceServer) {
+	// If the following call pancis, it indicates UnimplementedOnuServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OnuService_ServiceDesc, srv)
+}
+
+func _OnuService_ListOnus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOnusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnuServiceServer).ListOnus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnuService_ListOnus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnuServiceServer).ListOnus(ctx, req.(*ListOnusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnuService_GetOnu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOnuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnuServiceServer).GetOnu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnuService_GetOnu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnuServiceServer).GetOnu(ctx, req.(*GetOnuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnuService_ListEmptyOnuIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmptyOnuIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnuServiceServer).ListEmptyOnuIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnuService_ListEmptyOnuIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnuServiceServer).ListEmptyOnuIDs(ctx, req.(*ListEmptyOnuIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnuService_ListOnuSerialNumbers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOnuSerialNumbersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnuServiceServer).ListOnuSerialNumbers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnuService_ListOnuSerialNumbers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnuServiceServer).ListOnuSerialNumbers(ctx, req.(*ListOnuSerialNumbersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnuService_ListOnusPaginated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOnusPaginatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OnuServiceServer).ListOnusPaginated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OnuService_ListOnusPaginated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OnuServiceServer).ListOnusPaginated(ctx, req.(*ListOnusPaginatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OnuService_WatchOnuStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOnuStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OnuServiceServer).WatchOnuStatus(m, &grpc.GenericServerStream[WatchOnuStatusRequest, OnuStatusEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OnuService_WatchOnuStatusServer = grpc.ServerStreamingServer[OnuStatusEvent]
+
+// OnuService_ServiceDesc is the grpc.ServiceDesc for OnuService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OnuService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "onu.v1.OnuService",
+	HandlerType: (*OnuServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListOnus",
+			Handler:    _OnuService_ListOnus_Handler,
+		},
+		{
+			MethodName: "GetOnu",
+			Handler:    _OnuService_GetOnu_Handler,
+		},
+		{
+			MethodName: "ListEmptyOnuIDs",
+			Handler:    _OnuService_ListEmptyOnuIDs_Handler,
+		},
+		{
+			MethodName: "ListOnuSerialNumbers",
+			Handler:    _OnuService_ListOnuSerialNumbers_Handler,
+		},
+		{
+			MethodName: "ListOnusPaginated",
+			Handler:    _OnuService_ListOnusPaginated_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchOnuStatus",
+			Handler:       _OnuService_WatchOnuStatus_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "onu/v1/onu.proto",
+}